@@ -10,6 +10,7 @@ import (
 var generateFlags struct {
 	dir      string
 	excludes []string
+	nilSafe  bool
 }
 
 // generateCmd represents the generate command
@@ -23,7 +24,9 @@ var generateCmd = &cobra.Command{
 			log.Fatalln(err)
 		}
 
-		lombok.Generate(dir, generateFlags.excludes)
+		lombok.Generate(dir, generateFlags.excludes, lombok.Options{
+			NilSafe: generateFlags.nilSafe,
+		})
 	},
 }
 
@@ -33,4 +36,5 @@ func init() {
 	// Here you will define your flags and configuration settings.
 	generateCmd.Flags().StringVarP(&generateFlags.dir, "dir", "d", "", "src code dir")
 	generateCmd.Flags().StringSliceVarP(&generateFlags.excludes, "exclude", "e", nil, "exclude path")
+	generateCmd.Flags().BoolVar(&generateFlags.nilSafe, "nil-safe", false, "generate nil-safe getters for all types")
 }
@@ -0,0 +1,171 @@
+package lombok
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"math"
+	"strconv"
+	"time"
+)
+
+// defaultValue 解析后的 default tag 值
+type defaultValue struct {
+	basic string // 属性的基础类型名，time.Duration 为 duration
+	lit   string // 生成代码中的字面量
+	unit  string // duration 的时间单位，如 Second，此时默认值为 lit * time.{unit}
+}
+
+// 支持 default tag 的整数类型及其位数
+var defaultIntBits = map[string]int{
+	"int": strconv.IntSize, "int8": 8, "int16": 16, "int32": 32, "int64": 64, "rune": 32,
+	"uint": strconv.IntSize, "uint8": 8, "uint16": 16, "uint32": 32, "uint64": 64, "byte": 8, "uintptr": 64,
+}
+
+// duration 字面量可用的时间单位，按从大到小的顺序
+var durationUnits = []struct {
+	name string
+	unit time.Duration
+}{
+	{"Hour", time.Hour}, {"Minute", time.Minute}, {"Second", time.Second},
+	{"Millisecond", time.Millisecond}, {"Microsecond", time.Microsecond}, {"Nanosecond", time.Nanosecond},
+}
+
+// defaultBasicType 返回属性类型对应的基础类型名，本包定义的类型按其底层类型判断，不支持 default 的类型返回空字符串
+func (pkg *PkgInfo) defaultBasicType(typ ast.Expr) string {
+	for depth := 0; depth < 8; depth++ {
+		switch x := typ.(type) {
+		case *ast.Ident:
+			if basicTypeNames[x.Name] {
+				return x.Name
+			}
+			t := pkg.FindType(x.Name)
+			if t == nil || t.Underlying == nil {
+				return ""
+			}
+			typ = t.Underlying
+		case *ast.SelectorExpr:
+			if pkgPath, name, _ := pkgTypeName(x); pkgPath == "time" && name == "Duration" {
+				return "duration"
+			}
+			return ""
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// parseDefault 按属性类型解析 default tag 值，值与类型不符或等于零值时返回错误
+func (pkg *PkgInfo) parseDefault(typ ast.Expr, raw string) (defaultValue, error) {
+	basic := pkg.defaultBasicType(typ)
+	value := defaultValue{basic: basic}
+	switch basic {
+	case "":
+		return value, errors.New("default 仅适用于整数、浮点数、字符串、布尔及 time.Duration 类型的属性")
+	case "string":
+		if raw == "" {
+			return value, errors.New("default 值不可为零值")
+		}
+		value.lit = strconv.Quote(raw)
+	case "bool":
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return value, fmt.Errorf(`错误的 default 值 "%s": 不是合法的 bool 值`, raw)
+		}
+		if !b {
+			return value, errors.New("default 值不可为零值")
+		}
+		value.lit = "true"
+	case "float32", "float64":
+		bits := 64
+		if basic == "float32" {
+			bits = 32
+		}
+		f, err := strconv.ParseFloat(raw, bits)
+		if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return value, fmt.Errorf(`错误的 default 值 "%s": 不是合法的 %s 值`, raw, basic)
+		}
+		if f == 0 {
+			return value, errors.New("default 值不可为零值")
+		}
+		value.lit = strconv.FormatFloat(f, 'g', -1, bits)
+	case "duration":
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return value, fmt.Errorf(`错误的 default 值 "%s": 不是合法的 time.Duration 值`, raw)
+		}
+		if d == 0 {
+			return value, errors.New("default 值不可为零值")
+		}
+		for _, u := range durationUnits {
+			if d%u.unit == 0 {
+				value.lit, value.unit = strconv.FormatInt(int64(d/u.unit), 10), u.name
+				break
+			}
+		}
+	default:
+		bits, ok := defaultIntBits[basic]
+		if !ok { // complex 等类型
+			return value, fmt.Errorf("default 不支持 %s 类型的属性", basic)
+		}
+		var n string
+		if basic[0] == 'u' || basic == "byte" {
+			u, err := strconv.ParseUint(raw, 0, bits)
+			if err != nil {
+				return value, fmt.Errorf(`错误的 default 值 "%s": 不是合法的 %s 值`, raw, basic)
+			}
+			n = strconv.FormatUint(u, 10)
+		} else {
+			i, err := strconv.ParseInt(raw, 0, bits)
+			if err != nil {
+				return value, fmt.Errorf(`错误的 default 值 "%s": 不是合法的 %s 值`, raw, basic)
+			}
+			n = strconv.FormatInt(i, 10)
+		}
+		if n == "0" {
+			return value, errors.New("default 值不可为零值")
+		}
+		value.lit = n
+	}
+	return value, nil
+}
+
+// defaultExpr 返回属性默认值的表达式，如 8080 / "localhost" / 5 * time.Second
+// 本包定义的以 time.Duration 为底层类型的类型需要类型转换，如 Timeout(5 * time.Second)
+func (b *propertiesFileBuilder) defaultExpr(prop *Property, value defaultValue) ast.Expr {
+	if value.basic == "bool" {
+		return ast.NewIdent(value.lit)
+	}
+	var expr ast.Expr = &ast.BasicLit{Kind: defaultLitKind(value.basic), Value: value.lit}
+	if value.unit != "" {
+		expr = &ast.BinaryExpr{X: expr, Op: token.MUL, Y: b.PkgIdent("time", value.unit)}
+		if pkgPath, name, _ := pkgTypeName(prop.Type); pkgPath != "time" || name != "Duration" {
+			expr = &ast.CallExpr{Fun: b.resolveType(prop.Type), Args: []ast.Expr{expr}}
+		}
+	}
+	return expr
+}
+
+// defaultLitKind 返回默认值字面量的 token 类型
+func defaultLitKind(basic string) token.Token {
+	switch basic {
+	case "string":
+		return token.STRING
+	case "float32", "float64":
+		return token.FLOAT
+	}
+	return token.INT
+}
+
+// zeroCheckExpr 返回判断 x 为零值的表达式，如 x == 0 / x == "" / !x
+func zeroCheckExpr(value defaultValue, x ast.Expr) ast.Expr {
+	switch value.basic {
+	case "bool":
+		return &ast.UnaryExpr{Op: token.NOT, X: x}
+	case "string":
+		return &ast.BinaryExpr{X: x, Op: token.EQL, Y: &ast.BasicLit{Kind: token.STRING, Value: `""`}}
+	}
+	return &ast.BinaryExpr{X: x, Op: token.EQL, Y: &ast.BasicLit{Kind: token.INT, Value: "0"}}
+}
@@ -0,0 +1,143 @@
+package lombok
+
+import (
+	"fmt"
+	"go/types"
+	"slices"
+	"strings"
+)
+
+// delegateMethod delegate 属性需要生成的转发方法
+type delegateMethod struct {
+	name string
+	sig  *types.Signature // 方法签名，已按属性类型的类型实参实例化
+}
+
+// resolveDelegates 计算 delegate 属性类型的方法集，仅在存在 delegate 属性时进行类型检查
+func (sc *scanner) resolveDelegates() {
+	if !sc.hasDelegate() {
+		return
+	}
+
+	tpkg, err := sc.typeCheck()
+	if err != nil {
+		sc.addError(fmt.Errorf("delegate 类型检查异常: %w", err))
+		return
+	}
+
+	for _, typ := range sc.pkg.SortedTypes() {
+		reserved := reservedMethodNames(typ)
+		delegated := make(map[string]string) // 方法名 => 提供该方法的属性名
+		for prop := range typ.Properties() {
+			if !prop.Delegate {
+				continue
+			}
+			field := lookupField(tpkg, typ.Name, prop.Name)
+			if field == nil || !isValidType(field.Type()) {
+				sc.addError(fmt.Errorf("类型 %s 的 %s 属性类型无法解析，不可生成 delegate 方法", typ.Name, prop.Name))
+				continue
+			}
+
+			for _, method := range delegateMethodSet(field.Type()) {
+				if reserved[method.name] {
+					continue
+				}
+				if other, exists := delegated[method.name]; exists {
+					sc.addError(fmt.Errorf("类型 %s 的 %s 属性与 %s 属性的 delegate 方法 %s 冲突", typ.Name, prop.Name, other, method.name))
+					continue
+				}
+				delegated[method.name] = prop.Name
+				prop.delegateMethods = append(prop.delegateMethods, method)
+			}
+		}
+	}
+}
+
+func (sc *scanner) hasDelegate() bool {
+	for _, typ := range sc.pkg.types {
+		for prop := range typ.Properties() {
+			if prop.Delegate {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lookupField 查找包中 struct 类型的字段
+func lookupField(tpkg *types.Package, typeName string, fieldName string) *types.Var {
+	obj, _ := tpkg.Scope().Lookup(typeName).(*types.TypeName)
+	if obj == nil {
+		return nil
+	}
+	st, _ := obj.Type().Underlying().(*types.Struct)
+	if st == nil {
+		return nil
+	}
+	for i := range st.NumFields() {
+		if st.Field(i).Name() == fieldName {
+			return st.Field(i)
+		}
+	}
+	return nil
+}
+
+// isValidType 判断类型是否已成功解析
+func isValidType(typ types.Type) bool {
+	return !strings.Contains(types.TypeString(typ, nil), "invalid type")
+}
+
+// delegateMethodSet 返回通过属性可调用的导出方法，按方法名排序
+// 非指针、非接口类型的属性可寻址，其指针方法也可调用
+func delegateMethodSet(typ types.Type) []delegateMethod {
+	if _, isPtr := typ.(*types.Pointer); !isPtr && !types.IsInterface(typ) {
+		typ = types.NewPointer(typ)
+	}
+
+	var methods []delegateMethod
+	mset := types.NewMethodSet(typ)
+	for i := range mset.Len() {
+		sel := mset.At(i)
+		if !sel.Obj().Exported() {
+			continue
+		}
+		methods = append(methods, delegateMethod{name: sel.Obj().Name(), sig: sel.Type().(*types.Signature)})
+	}
+	slices.SortFunc(methods, func(a, b delegateMethod) int {
+		return strings.Compare(a.name, b.name)
+	})
+	return methods
+}
+
+// reservedMethodNames 返回类型上已存在或将由 go-lombok 生成的方法名及字段名，delegate 不生成同名方法
+func reservedMethodNames(typ *Type) map[string]bool {
+	reserved := make(map[string]bool)
+	for name := range typ.existingMethods {
+		reserved[name] = true
+	}
+	for prop := range typ.Properties() {
+		reserved[prop.Name] = true
+		names := []string{prop.Getter, prop.Setter, prop.Wither, prop.AtomicAdder, prop.AtomicCAS, prop.Iter}
+		for _, name := range append(names, collMethodNames(prop)...) {
+			if name != "" {
+				reserved[name] = true
+			}
+		}
+	}
+	if typ.ToString {
+		reserved["String"], reserved["GoString"] = true, true
+	}
+	if typ.Equal {
+		reserved["Equal"] = true
+	}
+	if typ.Hash {
+		reserved["Hash"] = true
+	}
+	if typ.Clone {
+		reserved["Clone"] = true
+	}
+	if typ.DirtyField != "" {
+		reserved["DirtyFields"], reserved["IsDirty"], reserved["ResetDirty"] = true, true, true
+	}
+	return reserved
+}
@@ -0,0 +1,128 @@
+package lombok
+
+import (
+	"cmp"
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// 注释指令前缀，如 //lombok:data
+const directivePrefix = "//lombok:"
+
+// directive 类型或字段注释中的 lombok 指令，如 //lombok:ctor required
+type directive struct {
+	Name string
+	Args string
+}
+
+// parseDirectives 解析注释中的 lombok 指令
+func parseDirectives(groups ...*ast.CommentGroup) []directive {
+	var directives []directive
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+		for _, comment := range group.List {
+			text, ok := strings.CutPrefix(comment.Text, directivePrefix)
+			if !ok {
+				continue
+			}
+			name, args, _ := strings.Cut(strings.TrimSpace(text), " ")
+			directives = append(directives, directive{Name: name, Args: strings.TrimSpace(args)})
+		}
+	}
+	return directives
+}
+
+// typeDirectives 类型注释指令中作用于所有字段的部分，需在字段解析完成后应用
+type typeDirectives struct {
+	getters bool // 为所有字段生成 getter
+	setters bool // 为所有字段生成 setter
+}
+
+// applyTypeDirective 应用类型级指令，类型级选项直接设置到 typ 上，作用于所有字段的选项记录到 td 中
+func (sc *scanner) applyTypeDirective(typ *Type, td *typeDirectives, d directive) error {
+	switch d.Name {
+	case "getter":
+		td.getters = true
+	case "setter":
+		td.setters = true
+	case "data": // getter + setter + tostring + equal + hash
+		td.getters, td.setters = true, true
+		typ.ToString, typ.Equal, typ.Hash = true, true, true
+	case "value": // 不可变对象: getter + 全参数构造函数 + tostring + equal + hash
+		td.getters = true
+		typ.AllArgsCtor = true
+		typ.ToString, typ.Equal, typ.Hash = true, true, true
+	case "builder":
+		typ.Builder = true
+	case "ctor":
+		return sc.parseCtorTag(typ, d.Args)
+	case "tostring":
+		typ.ToString = true
+	case "equal":
+		typ.Equal = true
+	case "hash":
+		typ.Equal, typ.Hash = true, true
+	case "clone":
+		typ.Clone = true
+	case "nilsafe":
+		typ.NilSafe = true
+	case "iface":
+		typ.Interface = true
+	case "options":
+		if d.Args != "" && !isValidIdent(d.Args) {
+			return fmt.Errorf(`错误的指令参数 "%s%s %s"`, directivePrefix, d.Name, d.Args)
+		}
+		typ.Options, typ.OptionsPrefix = true, d.Args
+	case "enum":
+		return fmt.Errorf(`指令 "%s%s" 仅适用于整数类型`, directivePrefix, d.Name)
+	case "guard":
+		if !isValidIdent(d.Args) {
+			return fmt.Errorf(`错误的指令参数 "%s%s %s"`, directivePrefix, d.Name, d.Args)
+		}
+		typ.Guard = d.Args
+	case "track":
+		typ.DirtyField = cmp.Or(d.Args, defaultDirtyField)
+	default:
+		return fmt.Errorf(`未知的指令 "%s%s"`, directivePrefix, d.Name)
+	}
+	return nil
+}
+
+// applyFieldDirective 应用字段级指令
+func (sc *scanner) applyFieldDirective(prop *Property, d directive) error {
+	switch d.Name {
+	case "ignore":
+		prop.Ignored = true
+		prop.StringExcluded = true
+		prop.EqualExcluded = true
+	default:
+		return fmt.Errorf(`未知的字段指令 "%s%s"`, directivePrefix, d.Name)
+	}
+	return nil
+}
+
+// applyFieldDefaults 为未通过 tag 指定 getter/setter 的字段应用类型指令的默认值
+// 仅作用于非导出的非嵌入字段，sync.Mutex 等不可复制的字段、脏字段掩码字段、锁字段及 //lombok:ignore 标记的字段除外
+func (td *typeDirectives) applyFieldDefaults(typ *Type) {
+	for prop := range typ.Properties() {
+		if prop.Ignored || prop.Embedded || ast.IsExported(prop.Name) || prop.Name == "_" {
+			continue
+		}
+		// 不可复制的类型不生成 getter / setter，sync/atomic 类型除外(生成 Load / Store 访问器)
+		if _, isAtomic := atomicValueType(prop.Type); isNoCopyType(prop.Type) && !isAtomic {
+			continue
+		}
+		if prop.Name == typ.DirtyField || prop.Name == typ.Guard { // 脏字段掩码字段、锁字段
+			continue
+		}
+		if td.getters && prop.Getter == "" {
+			prop.Getter, prop.directiveGetter = pascalCase(prop.Name), true
+		}
+		if td.setters && prop.Setter == "" && prop.LazyLoader == "" { // 延迟加载的属性不生成 setter
+			prop.Setter, prop.directiveSetter = "Set"+pascalCase(prop.Name), true
+		}
+	}
+}
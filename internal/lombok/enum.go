@@ -0,0 +1,61 @@
+package lombok
+
+import (
+	"cmp"
+	"fmt"
+	"go/types"
+	"slices"
+)
+
+// resolveEnums 使用 go/types 收集枚举类型的常量，仅在存在枚举类型时进行类型检查
+// 常量按定义顺序排列，值相同的常量(别名)只保留第一个
+func (sc *scanner) resolveEnums() {
+	var enums []*Type
+	for _, typ := range sc.pkg.SortedTypes() {
+		if typ.Enum {
+			enums = append(enums, typ)
+		}
+	}
+	if len(enums) == 0 {
+		return
+	}
+
+	tpkg, err := sc.typeCheck()
+	if err != nil {
+		sc.addError(fmt.Errorf("enum 类型检查异常: %w", err))
+		return
+	}
+
+	var consts []*types.Const
+	for _, name := range tpkg.Scope().Names() {
+		if c, ok := tpkg.Scope().Lookup(name).(*types.Const); ok {
+			consts = append(consts, c)
+		}
+	}
+	slices.SortFunc(consts, func(a, b *types.Const) int {
+		return cmp.Compare(a.Pos(), b.Pos())
+	})
+
+	for _, typ := range enums {
+		if _, ok := defaultIntBits[sc.pkg.defaultBasicType(typ.Underlying)]; !ok {
+			sc.addError(fmt.Errorf("枚举类型 %s 的底层类型必须为整数类型", typ.Name))
+			continue
+		}
+
+		obj, _ := tpkg.Scope().Lookup(typ.Name).(*types.TypeName)
+		if obj == nil {
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, c := range consts {
+			if !types.Identical(c.Type(), obj.Type()) || seen[c.Val().ExactString()] {
+				continue
+			}
+			seen[c.Val().ExactString()] = true
+			typ.enumValues = append(typ.enumValues, c.Name())
+		}
+		if len(typ.enumValues) == 0 {
+			sc.addError(fmt.Errorf("枚举类型 %s 未定义常量", typ.Name))
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package lombok
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+)
+
+// foreignEqualKind 其他包的命名类型的比较方式
+type foreignEqualKind int
+
+const (
+	equalByOperator foreignEqualKind = iota + 1 // 底层类型为可比较的基础类型，使用 == 比较，如 time.Duration
+	equalByValue                                // 有 Equal(T) bool 方法，如 time.Time
+	equalByPointer                              // 有 Equal(*T) bool 方法
+)
+
+// resolveForeignEquals 通过 go/types 确定生成 Equal 方法的类型中，其他包的命名类型属性(或 slice / map 的元素)的比较方式，
+// 仅在存在此类属性时进行类型检查
+func (sc *scanner) resolveForeignEquals() {
+	candidates := make(map[string][2]string) // 包路径.类型名 => 包路径, 类型名
+	for _, typ := range sc.pkg.SortedTypes() {
+		if !typ.Equal || typ.ExistsMethod("Equal") {
+			continue
+		}
+		for prop := range typ.Properties() {
+			if prop.EqualExcluded || isNoCopyType(prop.Type) {
+				continue
+			}
+			valueType := prop.Type
+			switch t := valueType.(type) {
+			case *ast.ArrayType:
+				valueType = t.Elt
+			case *ast.MapType:
+				valueType = t.Value
+			}
+			if sel, ok := valueType.(*ast.SelectorExpr); ok {
+				if pkgPath, name, ok := pkgTypeName(sel); ok {
+					candidates[pkgPath+"."+name] = [2]string{pkgPath, name}
+				}
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	tpkg, err := sc.typeCheck()
+	if err != nil {
+		sc.addError(fmt.Errorf("equal 类型检查异常: %w", err))
+		return
+	}
+
+	sc.pkg.foreignEqual = make(map[string]foreignEqualKind)
+	for key, c := range candidates {
+		for _, imported := range tpkg.Imports() {
+			if imported.Path() != c[0] {
+				continue
+			}
+			obj, _ := imported.Scope().Lookup(c[1]).(*types.TypeName)
+			if obj == nil {
+				continue
+			}
+			if isPtr, ok := equalMethodParam(obj.Type()); ok {
+				sc.pkg.foreignEqual[key] = equalByValue
+				if isPtr {
+					sc.pkg.foreignEqual[key] = equalByPointer
+				}
+			} else if basic, ok := obj.Type().Underlying().(*types.Basic); ok && basic.Info()&types.IsUntyped == 0 {
+				sc.pkg.foreignEqual[key] = equalByOperator
+			}
+		}
+	}
+}
+
+// equalMethodParam 判断类型是否有 Equal(T) bool 或 Equal(*T) bool 方法，isPtr 为参数是否为指针
+func equalMethodParam(typ types.Type) (isPtr bool, ok bool) {
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(typ), true, nil, "Equal")
+	fn, _ := obj.(*types.Func)
+	if fn == nil {
+		return false, false
+	}
+	sig := fn.Type().(*types.Signature)
+	if sig.Params().Len() != 1 || sig.Results().Len() != 1 || !types.Identical(sig.Results().At(0).Type(), types.Typ[types.Bool]) {
+		return false, false
+	}
+	switch param := sig.Params().At(0).Type(); {
+	case types.Identical(param, typ):
+		return false, true
+	case types.Identical(param, types.NewPointer(typ)):
+		return true, true
+	}
+	return false, false
+}
+
+// foreignEqualKind 返回其他包的命名类型的比较方式，未确定时返回 0
+func (pkg *PkgInfo) foreignEqualKind(typ ast.Expr) foreignEqualKind {
+	sel, isSel := typ.(*ast.SelectorExpr)
+	if !isSel {
+		return 0
+	}
+	pkgPath, name, _ := pkgTypeName(sel)
+	return pkg.foreignEqual[pkgPath+"."+name]
+}
@@ -17,43 +17,124 @@ func GenFileCode(pkg *PkgInfo) string {
 
 type propertiesFileBuilder struct {
 	*astkit.FileBuilder
+	pkg *PkgInfo
+
+	useHashSeed bool // 是否有生成的 Hash 方法使用了 hash seed 变量
 }
 
 func (b *propertiesFileBuilder) generate(pkg *PkgInfo) *ast.File {
 	b.FileBuilder = astkit.NewFileBuilder(pkg.Name, pkg.Pkg)
+	b.pkg = pkg
 
 	for _, typ := range pkg.SortedTypes() {
-		for _, decl := range b.buildTypeProperties(typ) {
+		propDecls := b.buildTypeProperties(typ)
+		for _, decl := range propDecls {
 			b.FileBuilder.AddDecl(decl)
 		}
+		if typ.Interface {
+			for _, decl := range b.buildTypeInterfaces(typ, propDecls) {
+				b.FileBuilder.AddDecl(decl)
+			}
+		}
+		if typ.Enum {
+			for _, decl := range b.buildTypeEnum(typ) {
+				b.FileBuilder.AddDecl(decl)
+			}
+		}
+		if typ.AllArgsCtor || typ.RequiredArgsCtor {
+			for _, decl := range b.buildTypeConstructors(typ) {
+				b.FileBuilder.AddDecl(decl)
+			}
+		}
+		if typ.ToString {
+			for _, decl := range b.buildTypeToString(typ) {
+				b.FileBuilder.AddDecl(decl)
+			}
+		}
+		if typ.Equal || typ.Hash {
+			for _, decl := range b.buildTypeEqual(typ) {
+				b.FileBuilder.AddDecl(decl)
+			}
+		}
+		if typ.Clone {
+			for _, decl := range b.buildTypeClone(typ) {
+				b.FileBuilder.AddDecl(decl)
+			}
+		}
+		if typ.DirtyField != "" {
+			for _, decl := range b.buildTypeDirtyTracking(typ) {
+				b.FileBuilder.AddDecl(decl)
+			}
+		}
+		if typ.Builder {
+			for _, decl := range b.buildTypeBuilder(typ) {
+				b.FileBuilder.AddDecl(decl)
+			}
+		}
+		if typ.Options {
+			for _, decl := range b.buildTypeOptions(typ) {
+				b.FileBuilder.AddDecl(decl)
+			}
+		}
+	}
+
+	if b.useHashSeed {
+		b.FileBuilder.AddDecl(b.buildHashSeed())
 	}
 
 	return b.BuildFile()
 }
 
 func (b *propertiesFileBuilder) getRecvName(typ *Type) string {
-	recvName := typ.RecvName
-	if recvName == "" {
-		if existsRecvName, ok := typ.ExistsRecvName(); ok {
-			return existsRecvName
-		}
+	if typ.RecvName != "" {
+		return typ.RecvName
+	}
+	if existsRecvName, ok := typ.ExistsRecvName(); ok {
+		return existsRecvName
 	}
-
 	return "t"
 }
 
+// typeExpr 返回类型在方法 recv 等位置的引用表达式，泛型类型附带类型参数，如 Cache[K, V]
+func (b *propertiesFileBuilder) typeExpr(typ *Type) ast.Expr {
+	var args []ast.Expr
+	for _, name := range typ.TypeParamNames() {
+		args = append(args, ast.NewIdent(name))
+	}
+	return astkit.GenericType(ast.NewIdent(typ.Name), args...)
+}
+
+// typeParams 返回泛型类型的类型参数声明，用于生成的泛型类型或函数，非泛型类型返回 nil
+func (b *propertiesFileBuilder) typeParams(typ *Type) *ast.FieldList {
+	if typ.TypeParams == nil {
+		return nil
+	}
+
+	var fields []*ast.Field
+	for _, field := range typ.TypeParams.List {
+		fields = append(fields, &ast.Field{Names: field.Names, Type: b.resolveType(field.Type)})
+	}
+	return astkit.Fields(fields...)
+}
+
+// recvType 返回类型的属性方法及 String 等只读方法的接收者类型: *T，值接收者时为 T
+func (b *propertiesFileBuilder) recvType(typ *Type) ast.Expr {
+	if typ.ValueRecv {
+		return b.typeExpr(typ)
+	}
+	return astkit.RefType(b.typeExpr(typ))
+}
+
 func (b *propertiesFileBuilder) buildTypeProperties(typ *Type) []ast.Decl {
-	// build recv
+	// build recv，值接收者时为 (t T)
 	recvName := b.getRecvName(typ)
-	recv := astkit.Fields(
-		astkit.Field(ast.NewIdent(recvName), astkit.RefType(ast.NewIdent(typ.Name))),
-	)
+	recv := astkit.Fields(astkit.Field(ast.NewIdent(recvName), b.recvType(typ)))
 
 	var result []ast.Decl
 
 	for prop := range typ.Properties() {
 		// 跳过无需处理的属性
-		if prop.Getter == "" && prop.Setter == "" {
+		if !prop.HasAccessor() {
 			continue
 		}
 
@@ -62,24 +143,53 @@ func (b *propertiesFileBuilder) buildTypeProperties(typ *Type) []ast.Decl {
 		if valueName == recvName {
 			valueName = "value"
 		}
-		resolveTyp := b.resolveType(prop.Type)
+		// 属性类型及 getter/setter 的值类型在使用时才解析，避免为未使用的类型引入 import
+		propType := func() ast.Expr { return b.resolveType(prop.Type) }
+		valueType := propType
+
+		// sync/atomic 类型的属性通过 Load / Store 读写值，而非复制原子类型本身
+		loadValue := ast.Expr(propFetch)
+		storeValue := func(value ast.Expr) ast.Stmt { return astkit.AssignStmt(propFetch, value) }
+		atomicType, isAtomic := atomicValueType(prop.Type)
+		if isAtomic {
+			valueType = func() ast.Expr { return b.resolveType(atomicType) }
+			loadValue = &ast.CallExpr{Fun: astkit.SelectorExpr(propFetch, "Load")}
+			storeValue = func(value ast.Expr) ast.Stmt {
+				return &ast.ExprStmt{X: &ast.CallExpr{Fun: astkit.SelectorExpr(propFetch, "Store"), Args: []ast.Expr{value}}}
+			}
+		}
+
+		// 防御性复制: getter 返回副本，setter 保存参数的副本
+		var storedValue ast.Expr = ast.NewIdent(valueName)
+		if prop.CopyGetter {
+			loadValue = b.cloneCall(prop.Type, loadValue)
+		}
+		if prop.CopySetter {
+			storedValue = b.cloneCall(prop.Type, storedValue)
+		}
 
 		// getter
 		if isValidIdent(prop.Getter) {
-			if prop.IsRefGetter {
+			if prop.LazyLoader != "" {
+				getter := b.buildLazyGetter(typ, prop, recv, recvName)
+				b.nilSafeFunc(typ, recvName, getter, nil)
+				result = append(result, getter)
+			} else if prop.IsRefGetter {
 				getter := &ast.FuncDecl{
 					Recv: recv,
 					Name: ast.NewIdent(prop.Getter),
 					Type: &ast.FuncType{
 						Params: astkit.Fields(),
 						Results: astkit.Fields(&ast.Field{
-							Type: &ast.StarExpr{X: resolveTyp},
+							Type: &ast.StarExpr{X: propType()},
 						}),
 					},
 					Body: astkit.BlockStmt(
 						astkit.ReturnStmt(&ast.UnaryExpr{Op: token.AND, X: propFetch}),
 					),
 				}
+				b.guardFunc(typ, recvName, getter, false)
+				b.nilSafeFunc(typ, recvName, getter, nil)
 				result = append(result, getter)
 			} else {
 				getter := &ast.FuncDecl{
@@ -88,13 +198,26 @@ func (b *propertiesFileBuilder) buildTypeProperties(typ *Type) []ast.Decl {
 					Type: &ast.FuncType{
 						Params: astkit.Fields(),
 						Results: astkit.Fields(&ast.Field{
-							Type: resolveTyp,
+							Type: valueType(),
 						}),
 					},
 					Body: astkit.BlockStmt(
-						astkit.ReturnStmt(propFetch),
+						astkit.ReturnStmt(loadValue),
 					),
 				}
+
+				// 默认值: if t.x == 0 { return 8080 }，nil 安全时 recv 为 nil 也返回默认值
+				var nilValue ast.Expr
+				if prop.Default != "" {
+					value, _ := b.pkg.parseDefault(prop.Type, prop.Default)
+					nilValue = b.defaultExpr(prop, value)
+					getter.Body.List = append([]ast.Stmt{&ast.IfStmt{
+						Cond: zeroCheckExpr(value, propFetch),
+						Body: astkit.BlockStmt(astkit.ReturnStmt(nilValue)),
+					}}, getter.Body.List...)
+				}
+				b.guardFunc(typ, recvName, getter, false)
+				b.nilSafeFunc(typ, recvName, getter, nilValue)
 				result = append(result, getter)
 			}
 		}
@@ -106,41 +229,232 @@ func (b *propertiesFileBuilder) buildTypeProperties(typ *Type) []ast.Decl {
 				Name: ast.NewIdent(prop.Setter),
 				Type: &ast.FuncType{
 					Params: astkit.Fields(
-						astkit.Field(ast.NewIdent(valueName), resolveTyp),
+						astkit.Field(ast.NewIdent(valueName), valueType()),
 					),
 				},
+				Body: astkit.BlockStmt(),
+			}
+
+			// 赋值前校验: if err := t.validateX(v); err != nil { return err }
+			if prop.SetValidator != "" {
+				errName := freeName("err", recvName, valueName)
+				setter.Type.Results = astkit.Fields(&ast.Field{Type: ast.NewIdent("error")})
+				setter.Body.List = append(setter.Body.List, &ast.IfStmt{
+					Init: &ast.AssignStmt{
+						Lhs: []ast.Expr{ast.NewIdent(errName)},
+						Tok: token.DEFINE,
+						Rhs: []ast.Expr{&ast.CallExpr{
+							Fun:  astkit.SelectorExpr(ast.NewIdent(recvName), prop.SetValidator),
+							Args: []ast.Expr{ast.NewIdent(valueName)},
+						}},
+					},
+					Cond: &ast.BinaryExpr{X: ast.NewIdent(errName), Op: token.NEQ, Y: ast.NewIdent("nil")},
+					Body: astkit.BlockStmt(astkit.ReturnStmt(ast.NewIdent(errName))),
+				})
+			}
+
+			// 赋值，有后置 hook 时先保存旧值: old := t.x; t.x = v; t.afterSetX(old, v)
+			// sync/atomic 类型使用 Swap 原子地交换新旧值: old := t.x.Swap(v); t.afterSetX(old, v)
+			var afterHook ast.Stmt
+			if prop.AfterSetHook != "" {
+				oldName := freeName("old", recvName, valueName)
+				if isAtomic {
+					setter.Body.List = append(setter.Body.List, &ast.AssignStmt{
+						Lhs: []ast.Expr{ast.NewIdent(oldName)},
+						Tok: token.DEFINE,
+						Rhs: []ast.Expr{&ast.CallExpr{
+							Fun:  astkit.SelectorExpr(propFetch, "Swap"),
+							Args: []ast.Expr{ast.NewIdent(valueName)},
+						}},
+					})
+				} else {
+					setter.Body.List = append(setter.Body.List,
+						&ast.AssignStmt{
+							Lhs: []ast.Expr{ast.NewIdent(oldName)},
+							Tok: token.DEFINE,
+							Rhs: []ast.Expr{propFetch},
+						},
+						storeValue(storedValue),
+					)
+				}
+				afterHook = &ast.ExprStmt{X: &ast.CallExpr{
+					Fun:  astkit.SelectorExpr(ast.NewIdent(recvName), prop.AfterSetHook),
+					Args: []ast.Expr{ast.NewIdent(oldName), ast.NewIdent(valueName)},
+				}}
+			} else {
+				setter.Body.List = append(setter.Body.List, storeValue(storedValue))
+			}
+
+			// 脏字段跟踪: t.dirty |= 1 << n
+			if index := dirtyIndex(typ, prop); index >= 0 {
+				setter.Body.List = append(setter.Body.List, b.markDirtyStmt(typ, recvName, index))
+			}
+
+			// 后置 hook 在赋值及标记脏字段之后调用，有 guard 时在解锁之后调用
+			if afterHook != nil {
+				setter.Body.List = append(setter.Body.List, afterHook)
+			}
+
+			if prop.IsChainSetter {
+				setter.Type.Results = astkit.Fields(&ast.Field{Type: astkit.RefType(b.typeExpr(typ))})
+				setter.Body.List = append(setter.Body.List, astkit.ReturnStmt(ast.NewIdent(recvName)))
+			} else if prop.SetValidator != "" {
+				setter.Body.List = append(setter.Body.List, astkit.ReturnStmt(ast.NewIdent("nil")))
+			}
+			if afterHook != nil {
+				b.guardFuncBefore(typ, recvName, setter, afterHook)
+			} else {
+				b.guardFunc(typ, recvName, setter, true)
+			}
+			result = append(result, setter)
+
+			if isAtomic {
+				result = append(result, b.buildAtomicMethods(typ, prop, recv, recvName, valueType())...)
+			}
+		}
+
+		// 集合辅助方法
+		if prop.Coll != "" {
+			result = append(result, b.buildCollMethods(typ, prop, recv, recvName)...)
+		}
+
+		// 只读迭代器
+		if prop.Iter != "" && !typ.ExistsMethod(prop.Iter) {
+			result = append(result, b.buildIterGetter(typ, prop, recv, recvName))
+		}
+
+		// 转发方法
+		if prop.Delegate {
+			result = append(result, b.buildDelegateMethods(prop, recv, recvName)...)
+		}
+
+		// wither: 浅拷贝 recv，修改属性后返回副本；值接收者本身即为副本: t.x = v; return t
+		if isValidIdent(prop.Wither) && typ.ValueRecv {
+			result = append(result, &ast.FuncDecl{
+				Recv: recv,
+				Name: ast.NewIdent(prop.Wither),
+				Type: &ast.FuncType{
+					Params:  astkit.Fields(astkit.Field(ast.NewIdent(valueName), propType())),
+					Results: astkit.Fields(&ast.Field{Type: b.typeExpr(typ)}),
+				},
+				Body: astkit.BlockStmt(
+					astkit.AssignStmt(propFetch, ast.NewIdent(valueName)),
+					astkit.ReturnStmt(ast.NewIdent(recvName)),
+				),
+			})
+		} else if isValidIdent(prop.Wither) {
+			copyName := "cp"
+			if copyName == recvName || copyName == valueName {
+				copyName = "copied"
+			}
+			wither := &ast.FuncDecl{
+				Recv: recv,
+				Name: ast.NewIdent(prop.Wither),
+				Type: &ast.FuncType{
+					Params: astkit.Fields(
+						astkit.Field(ast.NewIdent(valueName), propType()),
+					),
+					Results: astkit.Fields(&ast.Field{Type: astkit.RefType(b.typeExpr(typ))}),
+				},
 				Body: astkit.BlockStmt(
+					&ast.AssignStmt{
+						Lhs: []ast.Expr{ast.NewIdent(copyName)},
+						Tok: token.DEFINE,
+						Rhs: []ast.Expr{&ast.StarExpr{X: ast.NewIdent(recvName)}},
+					},
 					astkit.AssignStmt(
-						propFetch,
+						astkit.SelectorExpr(ast.NewIdent(copyName), prop.Name),
 						ast.NewIdent(valueName),
 					),
+					astkit.ReturnStmt(&ast.UnaryExpr{Op: token.AND, X: ast.NewIdent(copyName)}),
 				),
 			}
-			result = append(result, setter)
+			result = append(result, wither)
 		}
 	}
 
 	// 首行注释
-	if len(result) > 0 {
-		result[0].(*ast.FuncDecl).Doc = astkit.DocComment("\n// properties for " + typ.Name)
-	}
+	setDeclsDoc(result, "\n// properties for "+typ.Name)
 
 	return result
 }
 
+// cloneCall 返回 slice / map 值 x 的浅拷贝表达式: slices.Clone(x) / maps.Clone(x)
+func (b *propertiesFileBuilder) cloneCall(typ ast.Expr, x ast.Expr) ast.Expr {
+	// 先确定包名再调用 PkgIdent，PkgIdent 会添加 import
+	pkgName := "slices"
+	if _, isMap := typ.(*ast.MapType); isMap {
+		pkgName = "maps"
+	}
+	return &ast.CallExpr{Fun: b.PkgIdent(pkgName, "Clone"), Args: []ast.Expr{x}}
+}
+
+// setDeclsDoc 为一组生成代码的首个声明设置注释
+func setDeclsDoc(decls []ast.Decl, doc string) {
+	if len(decls) == 0 {
+		return
+	}
+	switch decl := decls[0].(type) {
+	case *ast.FuncDecl:
+		decl.Doc = astkit.DocComment(doc)
+	case *ast.GenDecl:
+		decl.Doc = astkit.DocComment(doc)
+	}
+}
+
+// newFuncName 返回构造函数名，导出性与类型名保持一致，如 Config => NewConfig, config => newConfig
+func newFuncName(typeName string) string {
+	if ast.IsExported(typeName) {
+		return "New" + typeName
+	}
+	return "new" + pascalCase(typeName)
+}
+
+// resolveType 将类型表达式中的包路径转换为生成文件中的 import 别名，返回新的表达式，不修改原表达式
 func (b *propertiesFileBuilder) resolveType(typ ast.Expr) ast.Expr {
 	switch x := typ.(type) {
 	case *ast.SelectorExpr:
 		if ident, ok := x.X.(*ast.Ident); ok {
-			typ = b.PkgIdent(ident.Name, x.Sel.Name)
+			return b.PkgIdent(ident.Name, x.Sel.Name)
 		}
 	case *ast.StarExpr:
-		x.X = b.resolveType(x.X)
+		return &ast.StarExpr{X: b.resolveType(x.X)}
 	case *ast.IndexExpr:
-		x.X = b.resolveType(x.X)
-		x.Index = b.resolveType(x.Index)
+		return &ast.IndexExpr{X: b.resolveType(x.X), Index: b.resolveType(x.Index)}
+	case *ast.IndexListExpr:
+		indices := make([]ast.Expr, len(x.Indices))
+		for i, index := range x.Indices {
+			indices[i] = b.resolveType(index)
+		}
+		return &ast.IndexListExpr{X: b.resolveType(x.X), Indices: indices}
 	case *ast.ArrayType:
-		x.Elt = b.resolveType(x.Elt)
+		return &ast.ArrayType{Len: x.Len, Elt: b.resolveType(x.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: b.resolveType(x.Key), Value: b.resolveType(x.Value)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: x.Dir, Value: b.resolveType(x.Value)}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: b.resolveFieldTypes(x.Params), Results: b.resolveFieldTypes(x.Results)}
+	case *ast.InterfaceType: // 类型约束或接口类型，如 interface{ fmt.Stringer }
+		return &ast.InterfaceType{Methods: b.resolveFieldTypes(x.Methods)}
+	case *ast.BinaryExpr: // 类型约束的联合，如 ~int | time.Duration
+		return &ast.BinaryExpr{X: b.resolveType(x.X), Op: x.Op, Y: b.resolveType(x.Y)}
+	case *ast.UnaryExpr: // 类型约束的近似元素，如 ~int
+		return &ast.UnaryExpr{Op: x.Op, X: b.resolveType(x.X)}
+	case *ast.ParenExpr:
+		return &ast.ParenExpr{X: b.resolveType(x.X)}
 	}
 	return typ
 }
+
+// resolveFieldTypes 返回解析了各字段类型的字段列表副本，用于函数类型的参数、结果及接口的方法
+func (b *propertiesFileBuilder) resolveFieldTypes(fields *ast.FieldList) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+	result := &ast.FieldList{}
+	for _, field := range fields.List {
+		result.List = append(result.List, &ast.Field{Names: field.Names, Type: b.resolveType(field.Type)})
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+)
+
+// buildAtomicMethods 为 sync/atomic 类型的属性生成 set 选项指定的原子操作方法:
+//
+//	func (t *T) AddX(delta int64) int64 { return t.x.Add(delta) }
+//	func (t *T) CompareAndSwapX(old, new int64) bool { return t.x.CompareAndSwap(old, new) }
+//
+// 已存在同名方法时跳过生成
+func (b *propertiesFileBuilder) buildAtomicMethods(typ *Type, prop *Property, recv *ast.FieldList, recvName string, valueTyp ast.Expr) []ast.Decl {
+	propFetch := astkit.SelectorExpr(ast.NewIdent(recvName), prop.Name)
+	dirtyIdx := dirtyIndex(typ, prop)
+
+	var result []ast.Decl
+	if prop.AtomicAdder != "" && !typ.ExistsMethod(prop.AtomicAdder) {
+		deltaName := freeName("delta", recvName)
+		var body []ast.Stmt
+		if dirtyIdx >= 0 {
+			body = append(body, b.markDirtyStmt(typ, recvName, dirtyIdx))
+		}
+		body = append(body, astkit.ReturnStmt(&ast.CallExpr{
+			Fun:  astkit.SelectorExpr(propFetch, "Add"),
+			Args: []ast.Expr{ast.NewIdent(deltaName)},
+		}))
+		adder := &ast.FuncDecl{
+			Recv: recv,
+			Name: ast.NewIdent(prop.AtomicAdder),
+			Type: &ast.FuncType{
+				Params:  astkit.Fields(astkit.Field(ast.NewIdent(deltaName), valueTyp)),
+				Results: astkit.Fields(&ast.Field{Type: valueTyp}),
+			},
+			Body: astkit.BlockStmt(body...),
+		}
+		b.guardFunc(typ, recvName, adder, true)
+		result = append(result, adder)
+	}
+
+	if prop.AtomicCAS != "" && !typ.ExistsMethod(prop.AtomicCAS) {
+		oldName := freeName("old", recvName)
+		newName := freeName("new", recvName, oldName)
+		call := &ast.CallExpr{
+			Fun:  astkit.SelectorExpr(propFetch, "CompareAndSwap"),
+			Args: []ast.Expr{ast.NewIdent(oldName), ast.NewIdent(newName)},
+		}
+
+		// 有脏字段跟踪时仅在交换成功后标记:
+		//	if t.x.CompareAndSwap(old, new) { t.dirty |= 1 << n; return true }
+		//	return false
+		body := []ast.Stmt{astkit.ReturnStmt(call)}
+		if dirtyIdx >= 0 {
+			body = []ast.Stmt{
+				&ast.IfStmt{
+					Cond: call,
+					Body: astkit.BlockStmt(
+						b.markDirtyStmt(typ, recvName, dirtyIdx),
+						astkit.ReturnStmt(ast.NewIdent("true")),
+					),
+				},
+				astkit.ReturnStmt(ast.NewIdent("false")),
+			}
+		}
+		cas := &ast.FuncDecl{
+			Recv: recv,
+			Name: ast.NewIdent(prop.AtomicCAS),
+			Type: &ast.FuncType{
+				Params: astkit.Fields(&ast.Field{
+					Names: []*ast.Ident{ast.NewIdent(oldName), ast.NewIdent(newName)},
+					Type:  valueTyp,
+				}),
+				Results: astkit.Fields(&ast.Field{Type: ast.NewIdent("bool")}),
+			},
+			Body: astkit.BlockStmt(body...),
+		}
+		b.guardFunc(typ, recvName, cas, true)
+		result = append(result, cas)
+	}
+
+	return result
+}
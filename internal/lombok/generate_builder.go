@@ -0,0 +1,161 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"go/token"
+	"strconv"
+)
+
+// constructProperties 返回参与构造的属性列表(有 getter/setter 或必填的属性)，按类型定义字段顺序
+// sync.Mutex / atomic.Int64 等不可复制的类型无法作为参数传递，不参与构造
+func constructProperties(typ *Type) []*Property {
+	var props []*Property
+	for prop := range typ.Properties() {
+		if isNoCopyType(prop.Type) {
+			continue
+		}
+		if prop.HasAccessor() || prop.Required {
+			props = append(props, prop)
+		}
+	}
+	return props
+}
+
+// buildTypeBuilder 生成类型的 Builder，形如:
+//
+//	type TBuilder struct { target T; isSet [n]bool }
+//	func NewTBuilder() *TBuilder
+//	func (b *TBuilder) X(v X) *TBuilder
+//	func (b *TBuilder) Build() (*T, error)
+func (b *propertiesFileBuilder) buildTypeBuilder(typ *Type) []ast.Decl {
+	props := constructProperties(typ)
+	if len(props) == 0 {
+		return nil
+	}
+
+	builderName := typ.Name + "Builder"
+	var typeArgs []ast.Expr
+	for _, name := range typ.TypeParamNames() {
+		typeArgs = append(typeArgs, ast.NewIdent(name))
+	}
+	builderType := astkit.GenericType(ast.NewIdent(builderName), typeArgs...)
+
+	// 必填属性在 isSet 中的下标
+	requiredIndexes := make(map[string]int)
+	for _, prop := range props {
+		if prop.Required {
+			requiredIndexes[prop.Name] = len(requiredIndexes)
+		}
+	}
+
+	// type TBuilder struct
+	builderFields := []*ast.Field{
+		astkit.Field(ast.NewIdent("target"), b.typeExpr(typ)),
+	}
+	if len(requiredIndexes) > 0 {
+		builderFields = append(builderFields, astkit.Field(ast.NewIdent("isSet"), &ast.ArrayType{
+			Len: &ast.BasicLit{Kind: token.INT, Value: strconv.Itoa(len(requiredIndexes))},
+			Elt: ast.NewIdent("bool"),
+		}))
+	}
+	result := []ast.Decl{
+		&ast.GenDecl{
+			Tok: token.TYPE,
+			Specs: []ast.Spec{&ast.TypeSpec{
+				Name:       ast.NewIdent(builderName),
+				TypeParams: b.typeParams(typ),
+				Type:       &ast.StructType{Fields: astkit.Fields(builderFields...)},
+			}},
+		},
+	}
+
+	// func NewTBuilder() *TBuilder
+	if fnName := newFuncName(builderName); !b.pkg.ExistsFunc(fnName) {
+		result = append(result, &ast.FuncDecl{
+			Name: ast.NewIdent(fnName),
+			Type: &ast.FuncType{
+				TypeParams: b.typeParams(typ),
+				Params:     astkit.Fields(),
+				Results:    astkit.Fields(&ast.Field{Type: astkit.RefType(builderType)}),
+			},
+			Body: astkit.BlockStmt(
+				astkit.ReturnStmt(&ast.UnaryExpr{Op: token.AND, X: &ast.CompositeLit{Type: builderType}}),
+			),
+		})
+	}
+
+	// func (b *TBuilder) X(v X) *TBuilder
+	recv := astkit.Fields(astkit.Field(ast.NewIdent("b"), astkit.RefType(builderType)))
+	for _, prop := range props {
+		body := []ast.Stmt{
+			astkit.AssignStmt(astkit.SelectorExpr(ast.NewIdent("b"), "target", prop.Name), ast.NewIdent("v")),
+		}
+		if index, ok := requiredIndexes[prop.Name]; ok {
+			body = append(body, astkit.AssignStmt(b.isSetExpr(index), ast.NewIdent("true")))
+		}
+		body = append(body, astkit.ReturnStmt(ast.NewIdent("b")))
+
+		result = append(result, &ast.FuncDecl{
+			Recv: recv,
+			Name: ast.NewIdent(pascalCase(prop.Name)),
+			Type: &ast.FuncType{
+				Params:  astkit.Fields(astkit.Field(ast.NewIdent("v"), b.resolveType(prop.Type))),
+				Results: astkit.Fields(&ast.Field{Type: astkit.RefType(builderType)}),
+			},
+			Body: astkit.BlockStmt(body...),
+		})
+	}
+
+	// func (b *TBuilder) Build() (*T, error)
+	var buildBody []ast.Stmt
+	for _, prop := range props {
+		index, ok := requiredIndexes[prop.Name]
+		if !ok {
+			continue
+		}
+		buildBody = append(buildBody, &ast.IfStmt{
+			Cond: &ast.UnaryExpr{Op: token.NOT, X: b.isSetExpr(index)},
+			Body: astkit.BlockStmt(astkit.ReturnStmt(
+				ast.NewIdent("nil"),
+				&ast.CallExpr{
+					Fun:  b.PkgIdent("errors", "New"),
+					Args: []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(typ.Name + "." + prop.Name + " is required")}},
+				},
+			)),
+		})
+	}
+	var elts []ast.Expr
+	for _, prop := range props {
+		elts = append(elts, &ast.KeyValueExpr{
+			Key:   ast.NewIdent(prop.Name),
+			Value: astkit.SelectorExpr(ast.NewIdent("b"), "target", prop.Name),
+		})
+	}
+	buildBody = append(buildBody, astkit.ReturnStmt(
+		&ast.UnaryExpr{Op: token.AND, X: &ast.CompositeLit{Type: b.typeExpr(typ), Elts: elts}},
+		ast.NewIdent("nil"),
+	))
+	result = append(result, &ast.FuncDecl{
+		Recv: recv,
+		Name: ast.NewIdent("Build"),
+		Type: &ast.FuncType{
+			Params: astkit.Fields(),
+			Results: astkit.Fields(
+				&ast.Field{Type: astkit.RefType(b.typeExpr(typ))},
+				&ast.Field{Type: ast.NewIdent("error")},
+			),
+		},
+		Body: astkit.BlockStmt(buildBody...),
+	})
+
+	setDeclsDoc(result, "\n// builder for "+typ.Name)
+	return result
+}
+
+func (b *propertiesFileBuilder) isSetExpr(index int) ast.Expr {
+	return &ast.IndexExpr{
+		X:     astkit.SelectorExpr(ast.NewIdent("b"), "isSet"),
+		Index: &ast.BasicLit{Kind: token.INT, Value: strconv.Itoa(index)},
+	}
+}
@@ -0,0 +1,193 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"go/token"
+)
+
+// buildTypeClone 生成类型的深拷贝方法 Clone() *T，按属性类型复制:
+//   - slice / map 分配新的容器，元素有 Clone 方法时逐个 Clone，否则使用 slices.Clone / maps.Clone
+//   - 本包定义且有 Clone 方法(生成或手写，签名为 Clone() *T 或 Clone() T)的类型及其指针，调用其 Clone 方法
+//   - sync/atomic 类型通过 Load / Store 复制值
+//   - sync.Mutex / sync.RWMutex 保持零值，即未加锁的新锁(其他无法复制状态的 sync 类型由 scanner 报错)
+//   - 其他类型及标记为 clone:"shallow" 的属性直接赋值
+//
+// 已存在同名方法时跳过生成
+func (b *propertiesFileBuilder) buildTypeClone(typ *Type) []ast.Decl {
+	if typ.ExistsMethod("Clone") {
+		return nil
+	}
+
+	recvName := b.getRecvName(typ)
+	cloneName := freeName("c", recvName)
+	usedNames := []string{recvName, cloneName}
+
+	body := []ast.Stmt{
+		&ast.IfStmt{
+			Cond: &ast.BinaryExpr{X: ast.NewIdent(recvName), Op: token.EQL, Y: ast.NewIdent("nil")},
+			Body: astkit.BlockStmt(astkit.ReturnStmt(ast.NewIdent("nil"))),
+		},
+		&ast.AssignStmt{
+			Lhs: []ast.Expr{ast.NewIdent(cloneName)},
+			Tok: token.DEFINE,
+			Rhs: []ast.Expr{&ast.UnaryExpr{Op: token.AND, X: &ast.CompositeLit{Type: b.typeExpr(typ)}}},
+		},
+	}
+	body = append(body, b.guardStmts(typ, recvName, false)...)
+	for prop := range typ.Properties() {
+		src := astkit.SelectorExpr(ast.NewIdent(recvName), prop.Name)
+		dst := astkit.SelectorExpr(ast.NewIdent(cloneName), prop.Name)
+		if _, isAtomic := atomicValueType(prop.Type); isAtomic {
+			body = append(body, b.atomicCloneStmt(dst, src, prop.Type, usedNames))
+			continue
+		}
+		if isNoCopyType(prop.Type) { // sync.Mutex / sync.RWMutex，副本使用未加锁的新锁
+			continue
+		}
+		if prop.ShallowClone {
+			body = append(body, astkit.AssignStmt(dst, src))
+		} else {
+			body = append(body, b.cloneStmts(dst, src, prop.Type, usedNames)...)
+		}
+	}
+	body = append(body, astkit.ReturnStmt(ast.NewIdent(cloneName)))
+
+	result := []ast.Decl{
+		&ast.FuncDecl{
+			Recv: astkit.Fields(astkit.Field(ast.NewIdent(recvName), astkit.RefType(b.typeExpr(typ)))),
+			Name: ast.NewIdent("Clone"),
+			Type: &ast.FuncType{
+				Params:  astkit.Fields(),
+				Results: astkit.Fields(&ast.Field{Type: astkit.RefType(b.typeExpr(typ))}),
+			},
+			Body: astkit.BlockStmt(body...),
+		},
+	}
+	setDeclsDoc(result, "\n// clone method for "+typ.Name)
+	return result
+}
+
+// cloneResult 判断类型表达式(可为指针)是否为本包有 Clone 方法的类型，isPtr 为 Clone 是否返回指针:
+// 生成的 Clone 返回 *T；手写的 Clone 按返回值类型判断，返回值既不是 *T 也不是 T 时视为没有 Clone 方法
+func (b *propertiesFileBuilder) cloneResult(typ ast.Expr) (isPtr bool, ok bool) {
+	t := b.pkg.localType(typ)
+	if t == nil {
+		return false, false
+	}
+	if !t.ExistsMethod("Clone") {
+		return true, t.Clone
+	}
+
+	return selfTypeRef(t, t.cloneResult)
+}
+
+// cloneExpr 返回值 x 的深拷贝表达式，无需或无法深拷贝时返回 nil:
+//
+//	x.Clone()   // *T 类型，Clone 返回 *T
+//	*x.Clone()  // T 类型，Clone 返回 *T
+//	x.Clone()   // T 类型，Clone 返回 T
+//
+// Clone 返回 T 的类型的指针可能为 nil，调用值接收者的 Clone 会 panic，不深拷贝
+func (b *propertiesFileBuilder) cloneExpr(x ast.Expr, typ ast.Expr) ast.Expr {
+	isPtr, ok := b.cloneResult(typ)
+	if !ok {
+		return nil
+	}
+	call := &ast.CallExpr{Fun: astkit.SelectorExpr(x, "Clone")}
+	switch b.pkg.kindOf(typ) {
+	case kindPointer:
+		if isPtr {
+			return call
+		}
+	case kindStruct:
+		if isPtr {
+			return &ast.StarExpr{X: call}
+		}
+		return call
+	}
+	return nil
+}
+
+// cloneStmts 返回将 src 深拷贝到 dst 的语句
+func (b *propertiesFileBuilder) cloneStmts(dst, src ast.Expr, typ ast.Expr, usedNames []string) []ast.Stmt {
+	if value := b.cloneExpr(src, typ); value != nil {
+		return []ast.Stmt{astkit.AssignStmt(dst, value)}
+	}
+
+	var keyType, elemType ast.Expr
+	switch t := typ.(type) {
+	case *ast.ArrayType:
+		if t.Len == nil {
+			elemType = t.Elt
+		}
+	case *ast.MapType:
+		keyType, elemType = t.Key, t.Value
+	}
+	if elemType == nil { // 非容器类型直接赋值
+		return []ast.Stmt{astkit.AssignStmt(dst, src)}
+	}
+
+	// 元素无需深拷贝时使用 slices.Clone / maps.Clone
+	keyName := freeName("k", usedNames...)
+	elemName := freeName("e", usedNames...)
+	elemClone := b.cloneExpr(ast.NewIdent(elemName), elemType)
+	if elemClone == nil {
+		pkgName := "slices" // 先确定包名再调用 PkgIdent，PkgIdent 会添加 import
+		if keyType != nil {
+			pkgName = "maps"
+		}
+		return []ast.Stmt{astkit.AssignStmt(dst, &ast.CallExpr{Fun: b.PkgIdent(pkgName, "Clone"), Args: []ast.Expr{src}})}
+	}
+
+	// 元素需要深拷贝时逐个复制，保持 nil 与非 nil 的区别
+	//	if t.x != nil {
+	//		c.x = make([]E, len(t.x))
+	//		for k, e := range t.x {
+	//			c.x[k] = e.Clone()
+	//		}
+	//	}
+	return []ast.Stmt{
+		&ast.IfStmt{
+			Cond: &ast.BinaryExpr{X: src, Op: token.NEQ, Y: ast.NewIdent("nil")},
+			Body: astkit.BlockStmt(
+				astkit.AssignStmt(dst, &ast.CallExpr{
+					Fun:  ast.NewIdent("make"),
+					Args: []ast.Expr{b.resolveType(typ), &ast.CallExpr{Fun: ast.NewIdent("len"), Args: []ast.Expr{src}}},
+				}),
+				&ast.RangeStmt{
+					Key:   ast.NewIdent(keyName),
+					Value: ast.NewIdent(elemName),
+					Tok:   token.DEFINE,
+					X:     src,
+					Body: astkit.BlockStmt(
+						astkit.AssignStmt(&ast.IndexExpr{X: dst, Index: ast.NewIdent(keyName)}, elemClone),
+					),
+				},
+			),
+		},
+	}
+}
+
+// atomicCloneStmt 返回复制 sync/atomic 类型属性值的语句: c.x.Store(t.x.Load())
+// atomic.Value 不可 Store(nil)，未存储值时跳过:
+//
+//	if v := t.x.Load(); v != nil {
+//		c.x.Store(v)
+//	}
+func (b *propertiesFileBuilder) atomicCloneStmt(dst, src ast.Expr, typ ast.Expr, usedNames []string) ast.Stmt {
+	load := &ast.CallExpr{Fun: astkit.SelectorExpr(src, "Load")}
+	store := func(v ast.Expr) ast.Stmt {
+		return &ast.ExprStmt{X: &ast.CallExpr{Fun: astkit.SelectorExpr(dst, "Store"), Args: []ast.Expr{v}}}
+	}
+	if _, name, _ := pkgTypeName(typ); name != "Value" {
+		return store(load)
+	}
+
+	valueName := freeName("v", usedNames...)
+	return &ast.IfStmt{
+		Init: &ast.AssignStmt{Lhs: []ast.Expr{ast.NewIdent(valueName)}, Tok: token.DEFINE, Rhs: []ast.Expr{load}},
+		Cond: &ast.BinaryExpr{X: ast.NewIdent(valueName), Op: token.NEQ, Y: ast.NewIdent("nil")},
+		Body: astkit.BlockStmt(store(ast.NewIdent(valueName))),
+	}
+}
@@ -0,0 +1,146 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"go/token"
+)
+
+// collKeyElem 返回 slice / map 类型的键类型和元素类型，slice 的键类型为 int，非 slice / map 类型返回 nil
+func collKeyElem(typ ast.Expr) []ast.Expr {
+	switch x := typ.(type) {
+	case *ast.ArrayType:
+		if x.Len == nil {
+			return []ast.Expr{ast.NewIdent("int"), x.Elt}
+		}
+	case *ast.MapType:
+		return []ast.Expr{x.Key, x.Value}
+	}
+	return nil
+}
+
+// collMethodNames 返回属性的集合辅助方法名列表，未开启时返回 nil
+func collMethodNames(prop *Property) []string {
+	if prop.Coll == "" {
+		return nil
+	}
+	switch prop.Type.(type) {
+	case *ast.ArrayType:
+		return []string{"Add" + prop.Coll, "Remove" + prop.Coll + "At", prop.Coll + "Len", prop.Coll + "At"}
+	case *ast.MapType:
+		return []string{prop.Coll + "Get", "Put" + prop.Coll, "Delete" + prop.Coll, prop.Coll + "Len"}
+	}
+	return nil
+}
+
+// buildCollMethods 为 slice / map 类型的属性生成集合辅助方法，修改类方法按 setter 处理加锁和脏字段标记:
+//
+//	// slice
+//	func (t *T) AddX(v ...E) { t.x = append(t.x, v...) }
+//	func (t *T) RemoveXAt(i int) { t.x = slices.Delete(t.x, i, i+1) }
+//	func (t *T) XLen() int { return len(t.x) }
+//	func (t *T) XAt(i int) E { return t.x[i] }
+//	// map
+//	func (t *T) XGet(k K) (V, bool) { v, ok := t.x[k]; return v, ok }
+//	func (t *T) PutX(k K, v V) { if t.x == nil { t.x = make(map[K]V) }; t.x[k] = v }
+//	func (t *T) DeleteX(k K) { delete(t.x, k) }
+//	func (t *T) XLen() int { return len(t.x) }
+//
+// 已存在同名方法时跳过生成
+func (b *propertiesFileBuilder) buildCollMethods(typ *Type, prop *Property, recv *ast.FieldList, recvName string) []ast.Decl {
+	propFetch := astkit.SelectorExpr(ast.NewIdent(recvName), prop.Name)
+	keyElem := collKeyElem(prop.Type)
+	keyType, elemType := b.resolveType(keyElem[0]), b.resolveType(keyElem[1])
+	keyName := freeName("k", recvName)
+	valueName := freeName("v", recvName, keyName)
+	okName := freeName("ok", recvName, keyName, valueName)
+	indexName := freeName("i", recvName)
+
+	var result []ast.Decl
+	addMethod := func(name string, params *ast.FieldList, results *ast.FieldList, write bool, stmts ...ast.Stmt) {
+		if typ.ExistsMethod(name) {
+			return
+		}
+		// 修改类方法标记脏字段: t.dirty |= 1 << n
+		if index := dirtyIndex(typ, prop); write && index >= 0 {
+			stmts = append(stmts, b.markDirtyStmt(typ, recvName, index))
+		}
+		fn := &ast.FuncDecl{
+			Recv: recv,
+			Name: ast.NewIdent(name),
+			Type: &ast.FuncType{Params: params, Results: results},
+			Body: astkit.BlockStmt(stmts...),
+		}
+		b.guardFunc(typ, recvName, fn, write)
+		result = append(result, fn)
+	}
+	lenMethod := func(name string) {
+		addMethod(name, astkit.Fields(), astkit.Fields(&ast.Field{Type: ast.NewIdent("int")}), false,
+			astkit.ReturnStmt(&ast.CallExpr{Fun: ast.NewIdent("len"), Args: []ast.Expr{propFetch}}),
+		)
+	}
+
+	names := collMethodNames(prop)
+	switch prop.Type.(type) {
+	case *ast.ArrayType:
+		addName, removeName, lenName, atName := names[0], names[1], names[2], names[3]
+		addMethod(addName,
+			astkit.Fields(astkit.Field(ast.NewIdent(valueName), &ast.Ellipsis{Elt: elemType})),
+			nil, true,
+			astkit.AssignStmt(propFetch, &ast.CallExpr{
+				Fun:      ast.NewIdent("append"),
+				Args:     []ast.Expr{propFetch, ast.NewIdent(valueName)},
+				Ellipsis: 1,
+			}),
+		)
+		addMethod(removeName,
+			astkit.Fields(astkit.Field(ast.NewIdent(indexName), ast.NewIdent("int"))),
+			nil, true,
+			astkit.AssignStmt(propFetch, &ast.CallExpr{
+				Fun: b.PkgIdent("slices", "Delete"),
+				Args: []ast.Expr{
+					propFetch,
+					ast.NewIdent(indexName),
+					&ast.BinaryExpr{X: ast.NewIdent(indexName), Op: token.ADD, Y: &ast.BasicLit{Kind: token.INT, Value: "1"}},
+				},
+			}),
+		)
+		lenMethod(lenName)
+		addMethod(atName,
+			astkit.Fields(astkit.Field(ast.NewIdent(indexName), ast.NewIdent("int"))),
+			astkit.Fields(&ast.Field{Type: elemType}), false,
+			astkit.ReturnStmt(&ast.IndexExpr{X: propFetch, Index: ast.NewIdent(indexName)}),
+		)
+	case *ast.MapType:
+		getName, putName, deleteName, lenName := names[0], names[1], names[2], names[3]
+		addMethod(getName,
+			astkit.Fields(astkit.Field(ast.NewIdent(keyName), keyType)),
+			astkit.Fields(&ast.Field{Type: elemType}, &ast.Field{Type: ast.NewIdent("bool")}), false,
+			&ast.AssignStmt{
+				Lhs: []ast.Expr{ast.NewIdent(valueName), ast.NewIdent(okName)},
+				Tok: token.DEFINE,
+				Rhs: []ast.Expr{&ast.IndexExpr{X: propFetch, Index: ast.NewIdent(keyName)}},
+			},
+			astkit.ReturnStmt(ast.NewIdent(valueName), ast.NewIdent(okName)),
+		)
+		addMethod(putName,
+			astkit.Fields(astkit.Field(ast.NewIdent(keyName), keyType), astkit.Field(ast.NewIdent(valueName), elemType)),
+			nil, true,
+			&ast.IfStmt{
+				Cond: &ast.BinaryExpr{X: propFetch, Op: token.EQL, Y: ast.NewIdent("nil")},
+				Body: astkit.BlockStmt(astkit.AssignStmt(propFetch, &ast.CallExpr{
+					Fun:  ast.NewIdent("make"),
+					Args: []ast.Expr{b.resolveType(prop.Type)},
+				})),
+			},
+			astkit.AssignStmt(&ast.IndexExpr{X: propFetch, Index: ast.NewIdent(keyName)}, ast.NewIdent(valueName)),
+		)
+		addMethod(deleteName,
+			astkit.Fields(astkit.Field(ast.NewIdent(keyName), keyType)),
+			nil, true,
+			&ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("delete"), Args: []ast.Expr{propFetch, ast.NewIdent(keyName)}}},
+		)
+		lenMethod(lenName)
+	}
+	return result
+}
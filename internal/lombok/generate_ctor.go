@@ -0,0 +1,57 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"go/token"
+)
+
+// buildTypeConstructors 生成类型的构造函数:
+//   - 全参数构造函数 NewT(a A, b B, ...) *T，参数为所有参与构造的属性
+//   - 必填参数构造函数 NewTRequired(a A, ...) *T，参数为所有必填属性
+//
+// 包内已存在同名函数时跳过生成
+func (b *propertiesFileBuilder) buildTypeConstructors(typ *Type) []ast.Decl {
+	props := constructProperties(typ)
+
+	var result []ast.Decl
+	if fnName := newFuncName(typ.Name); typ.AllArgsCtor && !b.pkg.ExistsFunc(fnName) {
+		result = append(result, b.buildConstructor(typ, fnName, props))
+	}
+	if fnName := newFuncName(typ.Name) + "Required"; typ.RequiredArgsCtor && !b.pkg.ExistsFunc(fnName) {
+		var requiredProps []*Property
+		for _, prop := range props {
+			if prop.Required {
+				requiredProps = append(requiredProps, prop)
+			}
+		}
+		result = append(result, b.buildConstructor(typ, fnName, requiredProps))
+	}
+
+	setDeclsDoc(result, "\n// constructors for "+typ.Name)
+	return result
+}
+
+func (b *propertiesFileBuilder) buildConstructor(typ *Type, fnName string, props []*Property) ast.Decl {
+	var params []*ast.Field
+	var elts []ast.Expr
+	for _, prop := range props {
+		params = append(params, astkit.Field(ast.NewIdent(prop.Name), b.resolveType(prop.Type)))
+		elts = append(elts, &ast.KeyValueExpr{
+			Key:   ast.NewIdent(prop.Name),
+			Value: ast.NewIdent(prop.Name),
+		})
+	}
+
+	return &ast.FuncDecl{
+		Name: ast.NewIdent(fnName),
+		Type: &ast.FuncType{
+			TypeParams: b.typeParams(typ),
+			Params:     astkit.Fields(params...),
+			Results:    astkit.Fields(&ast.Field{Type: astkit.RefType(b.typeExpr(typ))}),
+		},
+		Body: astkit.BlockStmt(
+			astkit.ReturnStmt(&ast.UnaryExpr{Op: token.AND, X: &ast.CompositeLit{Type: b.typeExpr(typ), Elts: elts}}),
+		),
+	}
+}
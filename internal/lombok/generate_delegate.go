@@ -0,0 +1,85 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"go/parser"
+	"go/types"
+	"strconv"
+)
+
+// buildDelegateMethods 为 delegate 属性生成转发方法，将调用转发给属性值:
+//
+//	func (t *T) Read(p []byte) (n int, err error) {
+//		return t.r.Read(p)
+//	}
+//
+// 外部类型已定义或将生成的同名方法不生成
+func (b *propertiesFileBuilder) buildDelegateMethods(prop *Property, recv *ast.FieldList, recvName string) []ast.Decl {
+	var result []ast.Decl
+	for _, method := range prop.delegateMethods {
+		params := method.sig.Params()
+		var fields []*ast.Field
+		var args []ast.Expr
+		for i := range params.Len() {
+			param := params.At(i)
+			name := param.Name()
+			if !isValidIdent(name) || name == "_" || name == recvName {
+				name = "p" + strconv.Itoa(i)
+			}
+
+			var typeExpr ast.Expr
+			if method.sig.Variadic() && i == params.Len()-1 {
+				typeExpr = &ast.Ellipsis{Elt: b.typesExpr(param.Type().(*types.Slice).Elem())}
+			} else {
+				typeExpr = b.typesExpr(param.Type())
+			}
+			fields = append(fields, astkit.Field(ast.NewIdent(name), typeExpr))
+			args = append(args, ast.NewIdent(name))
+		}
+
+		var results []*ast.Field
+		for i := range method.sig.Results().Len() {
+			results = append(results, &ast.Field{Type: b.typesExpr(method.sig.Results().At(i).Type())})
+		}
+
+		call := &ast.CallExpr{
+			Fun:  astkit.SelectorExpr(ast.NewIdent(recvName), prop.Name, method.name),
+			Args: args,
+		}
+		if method.sig.Variadic() {
+			call.Ellipsis = 1
+		}
+		var stmt ast.Stmt = &ast.ExprStmt{X: call}
+		if len(results) > 0 {
+			stmt = astkit.ReturnStmt(call)
+		}
+
+		result = append(result, &ast.FuncDecl{
+			Recv: recv,
+			Name: ast.NewIdent(method.name),
+			Type: &ast.FuncType{
+				Params:  astkit.Fields(fields...),
+				Results: astkit.Fields(results...),
+			},
+			Body: astkit.BlockStmt(stmt),
+		})
+	}
+	return result
+}
+
+// typesExpr 将 go/types 的类型转换为生成文件中的类型表达式，其他包的类型使用生成文件的 import 别名
+func (b *propertiesFileBuilder) typesExpr(typ types.Type) ast.Expr {
+	qualifier := func(p *types.Package) string {
+		if p.Path() == b.pkg.Pkg {
+			return ""
+		}
+		sel := b.PkgIdent(p.Path(), "_").(*ast.SelectorExpr)
+		return sel.X.(*ast.Ident).Name
+	}
+	expr, err := parser.ParseExpr(types.TypeString(typ, qualifier))
+	if err != nil { // TypeString 的结果总是合法的类型表达式
+		panic(err)
+	}
+	return expr
+}
@@ -0,0 +1,190 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// buildTypeEnum 为枚举类型生成辅助方法，枚举值的字符串为常量名去除 EnumTrimPrefix 前缀:
+//
+//	func (t T) String() string
+//	func ParseT(s string) (T, error)
+//	func TValues() []T
+//	func (t T) IsValid() bool
+//	func (t T) MarshalText() ([]byte, error)
+//	func (t *T) UnmarshalText(text []byte) error
+//
+// 已存在同名方法或函数时跳过生成
+func (b *propertiesFileBuilder) buildTypeEnum(typ *Type) []ast.Decl {
+	recvName := b.getRecvName(typ)
+	valueRecv := astkit.Fields(astkit.Field(ast.NewIdent(recvName), ast.NewIdent(typ.Name)))
+	parseName := "Parse" + typ.Name
+	if !ast.IsExported(typ.Name) {
+		parseName = "parse" + pascalCase(typ.Name)
+	}
+
+	var result []ast.Decl
+	addFunc := func(fn *ast.FuncDecl) {
+		if (fn.Recv != nil && typ.ExistsMethod(fn.Name.Name)) || (fn.Recv == nil && b.pkg.ExistsFunc(fn.Name.Name)) {
+			return
+		}
+		result = append(result, fn)
+	}
+
+	// switch t { case A: return "A" ... }
+	var nameCases, parseCases, marshalCases []ast.Stmt
+	var values []ast.Expr
+	for _, name := range typ.enumValues {
+		text := &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(strings.TrimPrefix(name, typ.EnumTrimPrefix))}
+		nameCases = append(nameCases, &ast.CaseClause{
+			List: []ast.Expr{ast.NewIdent(name)},
+			Body: []ast.Stmt{astkit.ReturnStmt(text)},
+		})
+		parseCases = append(parseCases, &ast.CaseClause{
+			List: []ast.Expr{text},
+			Body: []ast.Stmt{astkit.ReturnStmt(ast.NewIdent(name), ast.NewIdent("nil"))},
+		})
+		marshalCases = append(marshalCases, &ast.CaseClause{
+			List: []ast.Expr{ast.NewIdent(name)},
+			Body: []ast.Stmt{astkit.ReturnStmt(
+				&ast.CallExpr{Fun: &ast.ArrayType{Elt: ast.NewIdent("byte")}, Args: []ast.Expr{text}},
+				ast.NewIdent("nil"),
+			)},
+		})
+		values = append(values, ast.NewIdent(name))
+	}
+
+	// String: 未定义的值输出为 T(n)
+	formatFunc, formatType := "FormatInt", "int64"
+	if basic := b.pkg.defaultBasicType(typ.Underlying); strings.HasPrefix(basic, "u") || basic == "byte" {
+		formatFunc, formatType = "FormatUint", "uint64"
+	}
+	addFunc(&ast.FuncDecl{
+		Recv: valueRecv,
+		Name: ast.NewIdent("String"),
+		Type: &ast.FuncType{Params: astkit.Fields(), Results: astkit.Fields(&ast.Field{Type: ast.NewIdent("string")})},
+		Body: astkit.BlockStmt(
+			&ast.SwitchStmt{Tag: ast.NewIdent(recvName), Body: astkit.BlockStmt(nameCases...)},
+			astkit.ReturnStmt(&ast.BinaryExpr{
+				X: &ast.BinaryExpr{
+					X:  &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(typ.Name + "(")},
+					Op: token.ADD,
+					Y: &ast.CallExpr{
+						Fun: b.PkgIdent("strconv", formatFunc),
+						Args: []ast.Expr{
+							&ast.CallExpr{Fun: ast.NewIdent(formatType), Args: []ast.Expr{ast.NewIdent(recvName)}},
+							&ast.BasicLit{Kind: token.INT, Value: "10"},
+						},
+					},
+				},
+				Op: token.ADD,
+				Y:  &ast.BasicLit{Kind: token.STRING, Value: `")"`},
+			}),
+		),
+	})
+
+	// ParseT: 未知的字符串返回错误
+	addFunc(&ast.FuncDecl{
+		Name: ast.NewIdent(parseName),
+		Type: &ast.FuncType{
+			Params:  astkit.Fields(astkit.Field(ast.NewIdent("s"), ast.NewIdent("string"))),
+			Results: astkit.Fields(&ast.Field{Type: ast.NewIdent(typ.Name)}, &ast.Field{Type: ast.NewIdent("error")}),
+		},
+		Body: astkit.BlockStmt(
+			&ast.SwitchStmt{Tag: ast.NewIdent("s"), Body: astkit.BlockStmt(parseCases...)},
+			astkit.ReturnStmt(
+				&ast.BasicLit{Kind: token.INT, Value: "0"},
+				&ast.CallExpr{
+					Fun: b.PkgIdent("fmt", "Errorf"),
+					Args: []ast.Expr{
+						&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote("invalid " + typ.Name + ": %q")},
+						ast.NewIdent("s"),
+					},
+				},
+			),
+		),
+	})
+
+	// TValues: 按定义顺序返回所有枚举值
+	addFunc(&ast.FuncDecl{
+		Name: ast.NewIdent(typ.Name + "Values"),
+		Type: &ast.FuncType{
+			Params:  astkit.Fields(),
+			Results: astkit.Fields(&ast.Field{Type: &ast.ArrayType{Elt: ast.NewIdent(typ.Name)}}),
+		},
+		Body: astkit.BlockStmt(astkit.ReturnStmt(
+			&ast.CompositeLit{Type: &ast.ArrayType{Elt: ast.NewIdent(typ.Name)}, Elts: values},
+		)),
+	})
+
+	// IsValid
+	addFunc(&ast.FuncDecl{
+		Recv: valueRecv,
+		Name: ast.NewIdent("IsValid"),
+		Type: &ast.FuncType{Params: astkit.Fields(), Results: astkit.Fields(&ast.Field{Type: ast.NewIdent("bool")})},
+		Body: astkit.BlockStmt(
+			&ast.SwitchStmt{Tag: ast.NewIdent(recvName), Body: astkit.BlockStmt(&ast.CaseClause{
+				List: values,
+				Body: []ast.Stmt{astkit.ReturnStmt(ast.NewIdent("true"))},
+			})},
+			astkit.ReturnStmt(ast.NewIdent("false")),
+		),
+	})
+
+	// MarshalText: 与 ParseT 使用相同的字符串(不依赖可能手写的 String 方法)，未定义的值返回错误
+	addFunc(&ast.FuncDecl{
+		Recv: valueRecv,
+		Name: ast.NewIdent("MarshalText"),
+		Type: &ast.FuncType{
+			Params:  astkit.Fields(),
+			Results: astkit.Fields(&ast.Field{Type: &ast.ArrayType{Elt: ast.NewIdent("byte")}}, &ast.Field{Type: ast.NewIdent("error")}),
+		},
+		Body: astkit.BlockStmt(
+			&ast.SwitchStmt{Tag: ast.NewIdent(recvName), Body: astkit.BlockStmt(marshalCases...)},
+			astkit.ReturnStmt(
+				ast.NewIdent("nil"),
+				&ast.CallExpr{
+					Fun: b.PkgIdent("fmt", "Errorf"),
+					Args: []ast.Expr{
+						&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote("invalid " + typ.Name + ": %d")},
+						ast.NewIdent(recvName),
+					},
+				},
+			),
+		),
+	})
+
+	// UnmarshalText
+	valueName := freeName("v", recvName)
+	errName := freeName("err", recvName, valueName)
+	addFunc(&ast.FuncDecl{
+		Recv: astkit.Fields(astkit.Field(ast.NewIdent(recvName), astkit.RefType(ast.NewIdent(typ.Name)))),
+		Name: ast.NewIdent("UnmarshalText"),
+		Type: &ast.FuncType{
+			Params:  astkit.Fields(astkit.Field(ast.NewIdent("text"), &ast.ArrayType{Elt: ast.NewIdent("byte")})),
+			Results: astkit.Fields(&ast.Field{Type: ast.NewIdent("error")}),
+		},
+		Body: astkit.BlockStmt(
+			&ast.AssignStmt{
+				Lhs: []ast.Expr{ast.NewIdent(valueName), ast.NewIdent(errName)},
+				Tok: token.DEFINE,
+				Rhs: []ast.Expr{&ast.CallExpr{
+					Fun:  ast.NewIdent(parseName),
+					Args: []ast.Expr{&ast.CallExpr{Fun: ast.NewIdent("string"), Args: []ast.Expr{ast.NewIdent("text")}}},
+				}},
+			},
+			&ast.IfStmt{
+				Cond: &ast.BinaryExpr{X: ast.NewIdent(errName), Op: token.NEQ, Y: ast.NewIdent("nil")},
+				Body: astkit.BlockStmt(astkit.ReturnStmt(ast.NewIdent(errName))),
+			},
+			astkit.AssignStmt(&ast.StarExpr{X: ast.NewIdent(recvName)}, ast.NewIdent(valueName)),
+			astkit.ReturnStmt(ast.NewIdent("nil")),
+		),
+	})
+
+	setDeclsDoc(result, "\n// enum methods for "+typ.Name)
+	return result
+}
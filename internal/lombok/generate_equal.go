@@ -0,0 +1,310 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"go/token"
+)
+
+// 生成的 Hash 方法共用的 hash seed 变量名
+const hashSeedVarName = "lombokHashSeed"
+
+// buildTypeEqual 生成类型的 Equal / Hash 方法，按字段定义顺序比较属性:
+//   - 可比较的基础类型使用 == 比较
+//   - slice / map 使用 slices.Equal / maps.Equal 逐元素比较
+//   - 本包定义且有 Equal 方法(生成或手写)的类型，调用其 Equal 方法
+//   - 其他包有 Equal(T) bool / Equal(*T) bool 方法的类型(通过 go/types 确定)，如 time.Time，调用其 Equal 方法
+//   - 其他无法确定的类型使用 reflect.DeepEqual 比较
+//   - sync.Mutex 等不可复制的类型不参与比较
+//
+// Hash 与 Equal 保持一致: 相等的对象 Hash 值相同。已存在同名方法时跳过生成
+func (b *propertiesFileBuilder) buildTypeEqual(typ *Type) []ast.Decl {
+	var result []ast.Decl
+	if typ.Equal && !typ.ExistsMethod("Equal") {
+		result = append(result, b.buildEqualMethod(typ))
+	}
+	if typ.Hash && !typ.ExistsMethod("Hash") {
+		result = append(result, b.buildHashMethod(typ))
+	}
+
+	setDeclsDoc(result, "\n// equal methods for "+typ.Name)
+	return result
+}
+
+func (b *propertiesFileBuilder) buildEqualMethod(typ *Type) ast.Decl {
+	recvName := b.getRecvName(typ)
+	otherName := freeName("other", recvName)
+
+	// if t == nil || other == nil { return t == other }
+	body := []ast.Stmt{
+		&ast.IfStmt{
+			Cond: &ast.BinaryExpr{
+				X:  &ast.BinaryExpr{X: ast.NewIdent(recvName), Op: token.EQL, Y: ast.NewIdent("nil")},
+				Op: token.LOR,
+				Y:  &ast.BinaryExpr{X: ast.NewIdent(otherName), Op: token.EQL, Y: ast.NewIdent("nil")},
+			},
+			Body: astkit.BlockStmt(astkit.ReturnStmt(
+				&ast.BinaryExpr{X: ast.NewIdent(recvName), Op: token.EQL, Y: ast.NewIdent(otherName)},
+			)),
+		},
+	}
+	// 仅对 recv 加锁，同时对 other 加锁时两个对象互相比较可能死锁
+	body = append(body, b.guardStmts(typ, recvName, false)...)
+	for prop := range typ.Properties() {
+		if prop.EqualExcluded || isNoCopyType(prop.Type) {
+			continue
+		}
+		x := astkit.SelectorExpr(ast.NewIdent(recvName), prop.Name)
+		y := astkit.SelectorExpr(ast.NewIdent(otherName), prop.Name)
+		body = append(body, &ast.IfStmt{
+			Cond: b.notEqualExpr(x, y, prop.Type),
+			Body: astkit.BlockStmt(astkit.ReturnStmt(ast.NewIdent("false"))),
+		})
+	}
+	body = append(body, astkit.ReturnStmt(ast.NewIdent("true")))
+
+	return &ast.FuncDecl{
+		Recv: astkit.Fields(astkit.Field(ast.NewIdent(recvName), astkit.RefType(b.typeExpr(typ)))),
+		Name: ast.NewIdent("Equal"),
+		Type: &ast.FuncType{
+			Params:  astkit.Fields(astkit.Field(ast.NewIdent(otherName), astkit.RefType(b.typeExpr(typ)))),
+			Results: astkit.Fields(&ast.Field{Type: ast.NewIdent("bool")}),
+		},
+		Body: astkit.BlockStmt(body...),
+	}
+}
+
+// equalParam 判断类型表达式(可为指针)是否为本包有 Equal 方法的类型，isPtr 为 Equal 的参数是否为指针:
+// 生成的 Equal 参数为 *T；手写的 Equal 按参数类型判断，参数既不是 *T 也不是 T 时视为没有 Equal 方法
+func (b *propertiesFileBuilder) equalParam(typ ast.Expr) (isPtr bool, ok bool) {
+	t := b.pkg.localType(typ)
+	if t == nil {
+		return false, false
+	}
+	if !t.ExistsMethod("Equal") {
+		return true, t.Equal
+	}
+	return selfTypeRef(t, t.equalParam)
+}
+
+// hasPtrEqual 判断类型表达式(可为指针)是否为本包有 Equal(*T) 方法的类型，指针属性可直接调用且 nil 安全(生成的 Equal 处理 nil)
+func (b *propertiesFileBuilder) hasPtrEqual(typ ast.Expr) bool {
+	isPtr, ok := b.equalParam(typ)
+	return ok && isPtr
+}
+
+// hasHash 判断类型表达式(可为指针)是否为本包有 Hash 方法的类型
+func (b *propertiesFileBuilder) hasHash(typ ast.Expr) bool {
+	t := b.pkg.localType(typ)
+	return t != nil && (t.Hash || t.ExistsMethod("Hash"))
+}
+
+// isComparableKind 判断类型是否可直接使用 == 比较且语义正确
+func (b *propertiesFileBuilder) isComparableKind(typ ast.Expr) bool {
+	switch b.pkg.kindOf(typ) {
+	case kindBasic, kindChan:
+		return true
+	case kindPointer:
+		return !b.hasPtrEqual(typ)
+	case kindArray:
+		return b.isComparableKind(typ.(*ast.ArrayType).Elt)
+	}
+	return false
+}
+
+// elemEqualFunc 返回元素的比较函数 func(a, b E) bool，元素类型无 Equal 方法时返回 nil
+func (b *propertiesFileBuilder) elemEqualFunc(elemType ast.Expr) ast.Expr {
+	if b.pkg.containsNoCopy(elemType) { // 比较函数的参数会复制锁
+		return nil
+	}
+	var call ast.Expr
+	switch b.pkg.kindOf(elemType) {
+	case kindPointer:
+		if !b.hasPtrEqual(elemType) {
+			return nil
+		}
+		call = equalCall(ast.NewIdent("a"), ast.NewIdent("b"))
+	case kindStruct:
+		isPtr, ok := b.equalParam(elemType)
+		if !ok {
+			return nil
+		}
+		call = equalCall(ast.NewIdent("a"), addrIf(isPtr, ast.NewIdent("b")))
+	default:
+		kind := b.pkg.foreignEqualKind(elemType)
+		if kind != equalByValue && kind != equalByPointer {
+			return nil
+		}
+		call = equalCall(ast.NewIdent("a"), addrIf(kind == equalByPointer, ast.NewIdent("b")))
+	}
+
+	resolvedType := b.resolveType(elemType)
+	return &ast.FuncLit{
+		Type: &ast.FuncType{
+			Params: astkit.Fields(&ast.Field{
+				Names: []*ast.Ident{ast.NewIdent("a"), ast.NewIdent("b")},
+				Type:  resolvedType,
+			}),
+			Results: astkit.Fields(&ast.Field{Type: ast.NewIdent("bool")}),
+		},
+		Body: astkit.BlockStmt(astkit.ReturnStmt(call)),
+	}
+}
+
+// notEqualExpr 返回 x 与 y 不相等时为 true 的表达式
+func (b *propertiesFileBuilder) notEqualExpr(x, y ast.Expr, typ ast.Expr) ast.Expr {
+	if b.isComparableKind(typ) {
+		return &ast.BinaryExpr{X: x, Op: token.NEQ, Y: y}
+	}
+
+	switch t := typ.(type) {
+	case *ast.StarExpr: // 有 Equal 方法的本包类型指针
+		return &ast.UnaryExpr{Op: token.NOT, X: equalCall(x, y)}
+	case *ast.ArrayType:
+		if t.Len != nil {
+			break
+		}
+		if b.isComparableKind(t.Elt) {
+			return b.notCall(b.PkgIdent("slices", "Equal"), x, y)
+		} else if fn := b.elemEqualFunc(t.Elt); fn != nil {
+			return b.notCall(b.PkgIdent("slices", "EqualFunc"), x, y, fn)
+		}
+	case *ast.MapType:
+		if b.isComparableKind(t.Value) {
+			return b.notCall(b.PkgIdent("maps", "Equal"), x, y)
+		} else if fn := b.elemEqualFunc(t.Value); fn != nil {
+			return b.notCall(b.PkgIdent("maps", "EqualFunc"), x, y, fn)
+		}
+	default:
+		if isPtr, ok := b.equalParam(typ); ok && b.pkg.kindOf(typ) == kindStruct && (isPtr || !b.pkg.containsNoCopy(typ)) {
+			return &ast.UnaryExpr{Op: token.NOT, X: equalCall(x, addrIf(isPtr, y))}
+		}
+		if kind := b.pkg.foreignEqualKind(typ); kind == equalByValue || kind == equalByPointer { // 其他包有 Equal 方法的类型，如 time.Time
+			return &ast.UnaryExpr{Op: token.NOT, X: equalCall(x, addrIf(kind == equalByPointer, y))}
+		}
+	}
+
+	if b.pkg.containsNoCopy(typ) { // 包含锁的 struct 传值会复制锁，比较其指针
+		x, y = &ast.UnaryExpr{Op: token.AND, X: x}, &ast.UnaryExpr{Op: token.AND, X: y}
+	}
+	return b.notCall(b.PkgIdent("reflect", "DeepEqual"), x, y)
+}
+
+func (b *propertiesFileBuilder) notCall(fn ast.Expr, args ...ast.Expr) ast.Expr {
+	return &ast.UnaryExpr{Op: token.NOT, X: &ast.CallExpr{Fun: fn, Args: args}}
+}
+
+// addrIf 在 isPtr 为 true 时返回 &x，否则返回 x
+func addrIf(isPtr bool, x ast.Expr) ast.Expr {
+	if isPtr {
+		return &ast.UnaryExpr{Op: token.AND, X: x}
+	}
+	return x
+}
+
+func equalCall(x, y ast.Expr) ast.Expr {
+	return &ast.CallExpr{Fun: astkit.SelectorExpr(x, "Equal"), Args: []ast.Expr{y}}
+}
+
+func (b *propertiesFileBuilder) buildHashMethod(typ *Type) ast.Decl {
+	b.useHashSeed = true
+
+	recvName := b.getRecvName(typ)
+	hashName := freeName("h", recvName)
+	elemName := freeName("e", recvName, hashName)
+
+	// var h maphash.Hash
+	// h.SetSeed(lombokHashSeed)
+	body := []ast.Stmt{
+		&ast.IfStmt{
+			Cond: &ast.BinaryExpr{X: ast.NewIdent(recvName), Op: token.EQL, Y: ast.NewIdent("nil")},
+			Body: astkit.BlockStmt(astkit.ReturnStmt(&ast.BasicLit{Kind: token.INT, Value: "0"})),
+		},
+		&ast.DeclStmt{Decl: &ast.GenDecl{
+			Tok: token.VAR,
+			Specs: []ast.Spec{&ast.ValueSpec{
+				Names: []*ast.Ident{ast.NewIdent(hashName)},
+				Type:  b.PkgIdent("hash/maphash", "Hash"),
+			}},
+		}},
+		&ast.ExprStmt{X: &ast.CallExpr{
+			Fun:  astkit.SelectorExpr(ast.NewIdent(hashName), "SetSeed"),
+			Args: []ast.Expr{ast.NewIdent(hashSeedVarName)},
+		}},
+	}
+	body = append(body, b.guardStmts(typ, recvName, false)...)
+
+	writeHash := func(value ast.Expr) ast.Stmt {
+		return &ast.ExprStmt{X: &ast.CallExpr{
+			Fun:  b.PkgIdent("hash/maphash", "WriteComparable"),
+			Args: []ast.Expr{&ast.UnaryExpr{Op: token.AND, X: ast.NewIdent(hashName)}, value},
+		}}
+	}
+	// hashValue 返回与 Equal 语义一致的可哈希值，无法确定时返回 nil(即不参与 Hash 计算)
+	hashValue := func(x ast.Expr, typ ast.Expr) ast.Expr {
+		if b.isComparableKind(typ) {
+			return x
+		}
+		switch b.pkg.kindOf(typ) {
+		case kindPointer, kindStruct:
+			if b.hasHash(typ) {
+				return &ast.CallExpr{Fun: astkit.SelectorExpr(x, "Hash")}
+			}
+		}
+		return nil
+	}
+
+	for prop := range typ.Properties() {
+		if prop.EqualExcluded || isNoCopyType(prop.Type) {
+			continue
+		}
+		x := astkit.SelectorExpr(ast.NewIdent(recvName), prop.Name)
+		if value := hashValue(x, prop.Type); value != nil {
+			body = append(body, writeHash(value))
+			continue
+		}
+
+		switch t := prop.Type.(type) {
+		case *ast.ArrayType: // slice 逐元素计算，无法计算元素时仅计算长度
+			if t.Len != nil {
+				continue
+			}
+			if value := hashValue(ast.NewIdent(elemName), t.Elt); value != nil && !b.pkg.containsNoCopy(t.Elt) {
+				body = append(body, &ast.RangeStmt{
+					Key:   ast.NewIdent("_"),
+					Value: ast.NewIdent(elemName),
+					Tok:   token.DEFINE,
+					X:     x,
+					Body:  astkit.BlockStmt(writeHash(value)),
+				})
+			} else {
+				body = append(body, writeHash(&ast.CallExpr{Fun: ast.NewIdent("len"), Args: []ast.Expr{x}}))
+			}
+		case *ast.MapType: // map 遍历顺序不确定，仅计算长度
+			body = append(body, writeHash(&ast.CallExpr{Fun: ast.NewIdent("len"), Args: []ast.Expr{x}}))
+		}
+	}
+	body = append(body, astkit.ReturnStmt(&ast.CallExpr{Fun: astkit.SelectorExpr(ast.NewIdent(hashName), "Sum64")}))
+
+	return &ast.FuncDecl{
+		Recv: astkit.Fields(astkit.Field(ast.NewIdent(recvName), astkit.RefType(b.typeExpr(typ)))),
+		Name: ast.NewIdent("Hash"),
+		Type: &ast.FuncType{
+			Params:  astkit.Fields(),
+			Results: astkit.Fields(&ast.Field{Type: ast.NewIdent("uint64")}),
+		},
+		Body: astkit.BlockStmt(body...),
+	}
+}
+
+// buildHashSeed 生成 Hash 方法共用的 hash seed 变量
+func (b *propertiesFileBuilder) buildHashSeed() ast.Decl {
+	return &ast.GenDecl{
+		Doc: astkit.DocComment("\n// hash seed for generated Hash methods"),
+		Tok: token.VAR,
+		Specs: []ast.Spec{&ast.ValueSpec{
+			Names:  []*ast.Ident{ast.NewIdent(hashSeedVarName)},
+			Values: []ast.Expr{&ast.CallExpr{Fun: b.PkgIdent("hash/maphash", "MakeSeed")}},
+		}},
+	}
+}
@@ -0,0 +1,72 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"slices"
+)
+
+// guardFunc 为类型指定了锁字段(guard)时，在生成的 getter/setter 函数体前加锁:
+//
+//	t.mu.RLock()         // 读写锁的 getter，其他情况为 Lock
+//	defer t.mu.RUnlock()
+func (b *propertiesFileBuilder) guardFunc(typ *Type, recvName string, fn *ast.FuncDecl, write bool) {
+	fn.Body.List = append(b.guardStmts(typ, recvName, write), fn.Body.List...)
+}
+
+// guardStmts 返回 guardFunc 的加锁语句，类型未指定锁字段时返回 nil。
+// 用于 Equal / Hash / Clone 等以 recv 判空开头的方法，在判空之后加锁
+func (b *propertiesFileBuilder) guardStmts(typ *Type, recvName string, write bool) []ast.Stmt {
+	guardProp := typ.FindProperty(typ.Guard)
+	if typ.Guard == "" || guardProp == nil {
+		return nil
+	}
+
+	lockName, unlockName := "Lock", "Unlock"
+	if isRW, _ := guardLockKind(guardProp.Type); isRW && !write {
+		lockName, unlockName = "RLock", "RUnlock"
+	}
+
+	return []ast.Stmt{
+		&ast.ExprStmt{X: guardCall(typ, recvName, lockName)},
+		&ast.DeferStmt{Call: guardCall(typ, recvName, unlockName)},
+	}
+}
+
+// guardFuncBefore 与 guardFunc 类似，但在 stop 语句(如 setter 的后置 hook)之前解锁，
+// 使 hook 中可以调用加锁的 getter/setter 而不会死锁。stop 之前的 if 语句中提前 return 时同样先解锁:
+//
+//	t.mu.Lock()
+//	if err := t.validateX(v); err != nil { t.mu.Unlock(); return err }
+//	old := t.x
+//	t.x = v
+//	t.mu.Unlock()
+//	t.afterSetX(old, v)
+func (b *propertiesFileBuilder) guardFuncBefore(typ *Type, recvName string, fn *ast.FuncDecl, stop ast.Stmt) {
+	if typ.Guard == "" || typ.FindProperty(typ.Guard) == nil {
+		return
+	}
+
+	unlock := func() ast.Stmt { return &ast.ExprStmt{X: guardCall(typ, recvName, "Unlock")} }
+	list := []ast.Stmt{&ast.ExprStmt{X: guardCall(typ, recvName, "Lock")}}
+	locked := true
+	for _, stmt := range fn.Body.List {
+		if stmt == stop {
+			list = append(list, unlock())
+			locked = false
+		} else if ifStmt, ok := stmt.(*ast.IfStmt); ok && locked {
+			if n := len(ifStmt.Body.List); n > 0 {
+				if _, isReturn := ifStmt.Body.List[n-1].(*ast.ReturnStmt); isReturn {
+					ifStmt.Body.List = slices.Insert(ifStmt.Body.List, n-1, unlock())
+				}
+			}
+		}
+		list = append(list, stmt)
+	}
+	fn.Body.List = list
+}
+
+// guardCall 返回锁字段的方法调用，如 t.mu.Lock()
+func guardCall(typ *Type, recvName string, method string) *ast.CallExpr {
+	return &ast.CallExpr{Fun: astkit.SelectorExpr(ast.NewIdent(recvName), typ.Guard, method)}
+}
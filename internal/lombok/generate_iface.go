@@ -0,0 +1,97 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"go/token"
+)
+
+// buildTypeInterfaces 基于生成的 getter / setter 生成接口及实现断言，接口方法签名取自生成的方法，保证两者一致:
+//
+//	type TGetter interface { X() int }
+//	type TAccessor interface { TGetter; SetX(v int) }
+//	var _ TAccessor = (*T)(nil)
+//
+// 无 setter 时仅生成 TGetter；泛型类型无法在包级实例化，不生成断言；同名类型已存在时跳过生成
+func (b *propertiesFileBuilder) buildTypeInterfaces(typ *Type, propDecls []ast.Decl) []ast.Decl {
+	getterName, accessorName := typ.Name+"Getter", typ.Name+"Accessor"
+
+	var getters, setters []*ast.Field
+	for prop := range typ.Properties() {
+		if fn := findFuncDecl(propDecls, prop.Getter); fn != nil {
+			getters = append(getters, &ast.Field{Names: []*ast.Ident{ast.NewIdent(fn.Name.Name)}, Type: fn.Type})
+		}
+		if fn := findFuncDecl(propDecls, prop.Setter); fn != nil {
+			setters = append(setters, &ast.Field{Names: []*ast.Ident{ast.NewIdent(fn.Name.Name)}, Type: fn.Type})
+		}
+	}
+
+	var result []ast.Decl
+	var implName string
+	if len(getters) > 0 && b.pkg.FindType(getterName) == nil {
+		result = append(result, b.interfaceDecl(typ, getterName, getters))
+		implName = getterName
+	}
+	if len(setters) > 0 && b.pkg.FindType(accessorName) == nil {
+		methods := setters
+		if implName == getterName {
+			methods = append([]*ast.Field{{Type: b.interfaceRef(typ, getterName)}}, setters...)
+		} else {
+			methods = append(getters, setters...)
+		}
+		result = append(result, b.interfaceDecl(typ, accessorName, methods))
+		implName = accessorName
+	}
+
+	// var _ TAccessor = (*T)(nil)
+	if implName != "" && typ.TypeParams == nil {
+		result = append(result, &ast.GenDecl{
+			Tok: token.VAR,
+			Specs: []ast.Spec{&ast.ValueSpec{
+				Names: []*ast.Ident{ast.NewIdent("_")},
+				Type:  ast.NewIdent(implName),
+				Values: []ast.Expr{&ast.CallExpr{
+					Fun:  &ast.ParenExpr{X: astkit.RefType(ast.NewIdent(typ.Name))},
+					Args: []ast.Expr{ast.NewIdent("nil")},
+				}},
+			}},
+		})
+	}
+
+	setDeclsDoc(result, "\n// interfaces for "+typ.Name)
+	return result
+}
+
+// interfaceDecl 生成接口类型声明，泛型类型的接口使用相同的类型参数
+func (b *propertiesFileBuilder) interfaceDecl(typ *Type, name string, methods []*ast.Field) ast.Decl {
+	return &ast.GenDecl{
+		Tok: token.TYPE,
+		Specs: []ast.Spec{&ast.TypeSpec{
+			Name:       ast.NewIdent(name),
+			TypeParams: b.typeParams(typ),
+			Type:       &ast.InterfaceType{Methods: astkit.Fields(methods...)},
+		}},
+	}
+}
+
+// interfaceRef 返回生成的接口类型的引用，泛型类型附带类型参数，如 CacheGetter[K, V]
+func (b *propertiesFileBuilder) interfaceRef(typ *Type, name string) ast.Expr {
+	var args []ast.Expr
+	for _, param := range typ.TypeParamNames() {
+		args = append(args, ast.NewIdent(param))
+	}
+	return astkit.GenericType(ast.NewIdent(name), args...)
+}
+
+// findFuncDecl 在生成的声明中按名称查找函数
+func findFuncDecl(decls []ast.Decl, name string) *ast.FuncDecl {
+	if name == "" {
+		return nil
+	}
+	for _, decl := range decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.Name == name {
+			return fn
+		}
+	}
+	return nil
+}
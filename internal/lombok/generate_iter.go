@@ -0,0 +1,41 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+)
+
+// buildIterGetter 为 slice / map 类型的属性生成只读迭代器方法，调用方可遍历内容但无法修改容器:
+//
+//	func (t *T) XAll() iter.Seq[E] { return slices.Values(t.x) } // slice
+//	func (t *T) XAll() iter.Seq2[K, V] { return maps.All(t.x) } // map
+//
+// 类型指定了锁字段(guard)时，在锁内复制容器后返回副本的迭代器，避免遍历期间持有锁
+func (b *propertiesFileBuilder) buildIterGetter(typ *Type, prop *Property, recv *ast.FieldList, recvName string) ast.Decl {
+	var src ast.Expr = astkit.SelectorExpr(ast.NewIdent(recvName), prop.Name)
+	keyElem := collKeyElem(prop.Type)
+
+	seqPkg, seqFunc := "slices", "Values"
+	resultType := astkit.GenericType(b.PkgIdent("iter", "Seq"), b.resolveType(keyElem[1]))
+	if _, isMap := prop.Type.(*ast.MapType); isMap {
+		seqPkg, seqFunc = "maps", "All"
+		resultType = astkit.GenericType(b.PkgIdent("iter", "Seq2"), b.resolveType(keyElem[0]), b.resolveType(keyElem[1]))
+	}
+	if typ.Guard != "" {
+		src = &ast.CallExpr{Fun: b.PkgIdent(seqPkg, "Clone"), Args: []ast.Expr{src}}
+	}
+
+	getter := &ast.FuncDecl{
+		Recv: recv,
+		Name: ast.NewIdent(prop.Iter),
+		Type: &ast.FuncType{
+			Params:  astkit.Fields(),
+			Results: astkit.Fields(&ast.Field{Type: resultType}),
+		},
+		Body: astkit.BlockStmt(
+			astkit.ReturnStmt(&ast.CallExpr{Fun: b.PkgIdent(seqPkg, seqFunc), Args: []ast.Expr{src}}),
+		),
+	}
+	b.guardFunc(typ, recvName, getter, false)
+	return getter
+}
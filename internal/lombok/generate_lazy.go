@@ -0,0 +1,48 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"go/token"
+)
+
+// buildLazyGetter 生成延迟加载的 getter，首次访问时调用加载方法初始化属性，之后返回缓存值:
+//
+//	func (t *T) X() X {
+//		t.xOnce.Do(func() {
+//			t.x = t.loadX()
+//		})
+//		return t.x
+//	}
+func (b *propertiesFileBuilder) buildLazyGetter(typ *Type, prop *Property, recv *ast.FieldList, recvName string) *ast.FuncDecl {
+	propFetch := astkit.SelectorExpr(ast.NewIdent(recvName), prop.Name)
+
+	resultType := b.resolveType(prop.Type)
+	var retValue = propFetch
+	if prop.IsRefGetter {
+		resultType = astkit.RefType(resultType)
+		retValue = &ast.UnaryExpr{Op: token.AND, X: propFetch}
+	}
+
+	return &ast.FuncDecl{
+		Recv: recv,
+		Name: ast.NewIdent(prop.Getter),
+		Type: &ast.FuncType{
+			Params:  astkit.Fields(),
+			Results: astkit.Fields(&ast.Field{Type: resultType}),
+		},
+		Body: astkit.BlockStmt(
+			&ast.ExprStmt{X: &ast.CallExpr{
+				Fun: astkit.SelectorExpr(ast.NewIdent(recvName), prop.LazyOnce, "Do"),
+				Args: []ast.Expr{&ast.FuncLit{
+					Type: &ast.FuncType{Params: astkit.Fields()},
+					Body: astkit.BlockStmt(astkit.AssignStmt(
+						propFetch,
+						&ast.CallExpr{Fun: astkit.SelectorExpr(ast.NewIdent(recvName), prop.LazyLoader)},
+					)),
+				}},
+			}},
+			astkit.ReturnStmt(retValue),
+		),
+	}
+}
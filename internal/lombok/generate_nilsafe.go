@@ -0,0 +1,53 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"go/token"
+)
+
+// nilSafeFunc 为类型开启了 nil 安全(nilsafe)时，在生成的 getter 函数体最前面加入 recv 为 nil 时返回零值的判断，
+// 使调用方可以链式调用 a.B().C() 而无需逐层判空:
+//
+//	if t == nil {
+//		return nil           // 指针、slice、map 等可为 nil 的类型
+//	}
+//	if t == nil {
+//		var zero X           // 其他类型
+//		return zero
+//	}
+//
+// 值接收者不可能为 nil，不做判断。nilValue 不为 nil 时返回 nilValue，如属性的默认值。判断位于 guard 加锁语句之前，需在 guardFunc 之后调用
+func (b *propertiesFileBuilder) nilSafeFunc(typ *Type, recvName string, fn *ast.FuncDecl, nilValue ast.Expr) {
+	if !typ.NilSafe || typ.ValueRecv || fn.Type.Results == nil || len(fn.Type.Results.List) != 1 {
+		return
+	}
+
+	resultType := fn.Type.Results.List[0].Type
+	var body []ast.Stmt
+	switch b.pkg.kindOf(resultType) {
+	case kindPointer, kindSlice, kindMap, kindInterface, kindFunc, kindChan:
+		body = []ast.Stmt{astkit.ReturnStmt(ast.NewIdent("nil"))}
+	default:
+		zeroName := freeName("zero", recvName)
+		body = []ast.Stmt{
+			&ast.DeclStmt{Decl: &ast.GenDecl{
+				Tok: token.VAR,
+				Specs: []ast.Spec{&ast.ValueSpec{
+					Names: []*ast.Ident{ast.NewIdent(zeroName)},
+					Type:  resultType,
+				}},
+			}},
+			astkit.ReturnStmt(ast.NewIdent(zeroName)),
+		}
+	}
+	if nilValue != nil {
+		body = []ast.Stmt{astkit.ReturnStmt(nilValue)}
+	}
+
+	nilCheck := &ast.IfStmt{
+		Cond: &ast.BinaryExpr{X: ast.NewIdent(recvName), Op: token.EQL, Y: ast.NewIdent("nil")},
+		Body: astkit.BlockStmt(body...),
+	}
+	fn.Body.List = append([]ast.Stmt{nilCheck}, fn.Body.List...)
+}
@@ -0,0 +1,105 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"go/token"
+)
+
+// optionFuncName 返回属性的函数式选项函数名，如 WithName / WithServerName，非导出类型为 withName
+func optionFuncName(typ *Type, prop *Property) string {
+	name := pascalCase(typ.OptionsPrefix) + pascalCase(prop.Name)
+	if ast.IsExported(typ.Name) {
+		return "With" + name
+	}
+	return "with" + name
+}
+
+// buildTypeOptions 生成类型的函数式选项，参与构造的属性与 Builder 一致:
+//
+//	type TOption func(*T)
+//	func WithX(v X) TOption
+//	func (t *T) applyOptions(opts ...TOption)
+//
+// 已存在同名类型、函数或方法时跳过生成
+func (b *propertiesFileBuilder) buildTypeOptions(typ *Type) []ast.Decl {
+	props := constructProperties(typ)
+	if len(props) == 0 {
+		return nil
+	}
+
+	optionName := typ.Name + "Option"
+	var typeArgs []ast.Expr
+	for _, name := range typ.TypeParamNames() {
+		typeArgs = append(typeArgs, ast.NewIdent(name))
+	}
+	optionType := func() ast.Expr { return astkit.GenericType(ast.NewIdent(optionName), typeArgs...) }
+	recvName := b.getRecvName(typ)
+	valueName := freeName("v", recvName)
+
+	var result []ast.Decl
+
+	// type TOption func(*T)
+	if b.pkg.FindType(optionName) == nil {
+		result = append(result, &ast.GenDecl{
+			Tok: token.TYPE,
+			Specs: []ast.Spec{&ast.TypeSpec{
+				Name:       ast.NewIdent(optionName),
+				TypeParams: b.typeParams(typ),
+				Type: &ast.FuncType{
+					Params: astkit.Fields(&ast.Field{Type: astkit.RefType(b.typeExpr(typ))}),
+				},
+			}},
+		})
+	}
+
+	// func WithX(v X) TOption { return func(t *T) { t.x = v } }
+	for _, prop := range props {
+		fnName := optionFuncName(typ, prop)
+		if b.pkg.ExistsFunc(fnName) {
+			continue
+		}
+		result = append(result, &ast.FuncDecl{
+			Name: ast.NewIdent(fnName),
+			Type: &ast.FuncType{
+				TypeParams: b.typeParams(typ),
+				Params:     astkit.Fields(astkit.Field(ast.NewIdent(valueName), b.resolveType(prop.Type))),
+				Results:    astkit.Fields(&ast.Field{Type: optionType()}),
+			},
+			Body: astkit.BlockStmt(astkit.ReturnStmt(&ast.FuncLit{
+				Type: &ast.FuncType{
+					Params: astkit.Fields(astkit.Field(ast.NewIdent(recvName), astkit.RefType(b.typeExpr(typ)))),
+				},
+				Body: astkit.BlockStmt(
+					astkit.AssignStmt(astkit.SelectorExpr(ast.NewIdent(recvName), prop.Name), ast.NewIdent(valueName)),
+				),
+			})),
+		})
+	}
+
+	// func (t *T) applyOptions(opts ...TOption) { for _, opt := range opts { opt(t) } }
+	if !typ.ExistsMethod("applyOptions") {
+		optsName := freeName("opts", recvName)
+		optName := freeName("opt", recvName, optsName)
+		result = append(result, &ast.FuncDecl{
+			Recv: astkit.Fields(astkit.Field(ast.NewIdent(recvName), astkit.RefType(b.typeExpr(typ)))),
+			Name: ast.NewIdent("applyOptions"),
+			Type: &ast.FuncType{
+				Params: astkit.Fields(astkit.Field(ast.NewIdent(optsName), &ast.Ellipsis{Elt: optionType()})),
+			},
+			Body: astkit.BlockStmt(&ast.RangeStmt{
+				Key:   ast.NewIdent("_"),
+				Value: ast.NewIdent(optName),
+				Tok:   token.DEFINE,
+				X:     ast.NewIdent(optsName),
+				Body: astkit.BlockStmt(&ast.ExprStmt{X: &ast.CallExpr{
+					Fun:  ast.NewIdent(optName),
+					Args: []ast.Expr{ast.NewIdent(recvName)},
+				}}),
+			}),
+		})
+	}
+
+	setDeclsDoc(result, "\n// options for "+typ.Name)
+	return result
+}
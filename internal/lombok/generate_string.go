@@ -0,0 +1,94 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// 敏感属性在 String / GoString 中的掩码输出
+const sensitiveMask = "***"
+
+// buildTypeToString 生成类型的 String / GoString 方法，按字段定义顺序输出属性，如:
+//
+//	String():   T{host=localhost, port=8080, password=***}
+//	GoString(): T{host:"localhost", port:8080, password:"***"}
+//
+// 已存在同名方法时跳过生成
+func (b *propertiesFileBuilder) buildTypeToString(typ *Type) []ast.Decl {
+	var result []ast.Decl
+	if !typ.ExistsMethod("String") {
+		result = append(result, b.buildStringMethod(typ, "String", "=", "%v", sensitiveMask))
+	}
+	if !typ.ExistsMethod("GoString") {
+		result = append(result, b.buildStringMethod(typ, "GoString", ":", "%#v", strconv.Quote(sensitiveMask)))
+	}
+
+	setDeclsDoc(result, "\n// string methods for "+typ.Name)
+	return result
+}
+
+func (b *propertiesFileBuilder) buildStringMethod(typ *Type, fnName string, sep string, verb string, mask string) ast.Decl {
+	recvName := b.getRecvName(typ)
+
+	var parts []string
+	args := []ast.Expr{nil} // 首个参数为格式字符串
+	for prop := range typ.Properties() {
+		if prop.StringExcluded || isNoCopyType(prop.Type) {
+			continue
+		}
+		if prop.Sensitive {
+			parts = append(parts, prop.Name+sep+strings.ReplaceAll(mask, "%", "%%"))
+			continue
+		}
+		parts = append(parts, prop.Name+sep+verb)
+		var arg ast.Expr = astkit.SelectorExpr(ast.NewIdent(recvName), prop.Name)
+		if b.hasStringMethod(prop.Type, fnName) || b.pkg.containsNoCopy(prop.Type) {
+			// 传入指针以调用指针接收者的方法，使嵌套类型的敏感属性同样被掩码；包含锁的 struct 传值会复制锁
+			arg = &ast.UnaryExpr{Op: token.AND, X: arg}
+		}
+		args = append(args, arg)
+	}
+	format := typ.Name + "{" + strings.Join(parts, ", ") + "}"
+
+	var retValue ast.Expr
+	if len(args) == 1 { // 无需格式化的属性时直接返回字符串
+		retValue = &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(strings.ReplaceAll(format, "%%", "%"))}
+	} else {
+		args[0] = &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(format)}
+		retValue = &ast.CallExpr{Fun: b.PkgIdent("fmt", "Sprintf"), Args: args}
+	}
+
+	fn := &ast.FuncDecl{
+		Recv: astkit.Fields(astkit.Field(ast.NewIdent(recvName), b.recvType(typ))),
+		Name: ast.NewIdent(fnName),
+		Type: &ast.FuncType{
+			Params:  astkit.Fields(),
+			Results: astkit.Fields(&ast.Field{Type: ast.NewIdent("string")}),
+		},
+		Body: astkit.BlockStmt(
+			astkit.ReturnStmt(retValue),
+		),
+	}
+	b.guardFunc(typ, recvName, fn, false)
+	return fn
+}
+
+// hasStringMethod 判断属性类型是否为已有或将生成 String / GoString 方法的本包类型
+// 生成的方法默认为指针接收者，值类型的属性需取地址后格式化才会调用；指针的方法集包含值接收者的方法
+func (b *propertiesFileBuilder) hasStringMethod(typ ast.Expr, fnName string) bool {
+	switch x := typ.(type) {
+	case *ast.IndexExpr: // T[K]
+		typ = x.X
+	case *ast.IndexListExpr: // T[K, V]
+		typ = x.X
+	}
+	ident, ok := typ.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	t := b.pkg.FindType(ident.Name)
+	return t != nil && (t.ToString || t.ExistsMethod(fnName))
+}
@@ -0,0 +1,184 @@
+package lombok
+
+import (
+	"github.com/heyuuu/go-lombok/internal/utils/astkit"
+	"go/ast"
+	"go/token"
+	"slices"
+	"strconv"
+)
+
+// 未指定时脏字段跟踪使用的掩码字段名
+const defaultDirtyField = "dirty"
+
+// trackedProperties 返回参与脏字段跟踪的属性(有 setter 或集合辅助方法的属性)，顺序即其在掩码中的位序
+func trackedProperties(typ *Type) []*Property {
+	var props []*Property
+	for prop := range typ.Properties() {
+		if (prop.Setter != "" || prop.Coll != "") && prop.Name != typ.DirtyField {
+			props = append(props, prop)
+		}
+	}
+	return props
+}
+
+// dirtyIndex 返回属性在掩码中的位序，不跟踪时返回 -1
+func dirtyIndex(typ *Type, prop *Property) int {
+	if typ.DirtyField == "" {
+		return -1
+	}
+	return slices.Index(trackedProperties(typ), prop)
+}
+
+// dirtyFieldConst 返回属性名常量名，如 ServerFieldHost
+func dirtyFieldConst(typ *Type, prop *Property) string {
+	return typ.Name + "Field" + pascalCase(prop.Name)
+}
+
+// markDirtyStmt 返回标记属性已修改的语句: t.dirty |= 1 << n
+func (b *propertiesFileBuilder) markDirtyStmt(typ *Type, recvName string, index int) ast.Stmt {
+	return &ast.AssignStmt{
+		Lhs: []ast.Expr{astkit.SelectorExpr(ast.NewIdent(recvName), typ.DirtyField)},
+		Tok: token.OR_ASSIGN,
+		Rhs: []ast.Expr{&ast.BinaryExpr{
+			X:  &ast.BasicLit{Kind: token.INT, Value: "1"},
+			Op: token.SHL,
+			Y:  &ast.BasicLit{Kind: token.INT, Value: strconv.Itoa(index)},
+		}},
+	}
+}
+
+// buildTypeDirtyTracking 生成脏字段跟踪相关代码:
+//
+//	const ( TFieldX = "x"; ... )
+//	var lombokTDirtyFields = [...]string{TFieldX, ...}
+//	func (t *T) DirtyFields() []string
+//	func (t *T) IsDirty(name string) bool
+//	func (t *T) ResetDirty()
+//
+// 生成的 setter 会在赋值后标记对应属性；指定了锁字段(guard)时以上方法加锁
+func (b *propertiesFileBuilder) buildTypeDirtyTracking(typ *Type) []ast.Decl {
+	props := trackedProperties(typ)
+	if len(props) == 0 {
+		return nil
+	}
+
+	recvName := b.getRecvName(typ)
+	recv := astkit.Fields(astkit.Field(ast.NewIdent(recvName), astkit.RefType(b.typeExpr(typ))))
+	mask := astkit.SelectorExpr(ast.NewIdent(recvName), typ.DirtyField)
+	namesVar := "lombok" + pascalCase(typ.Name) + "DirtyFields"
+	indexName := freeName("i", recvName)
+	nameName := freeName("name", recvName, indexName)
+	fieldsName := freeName("fields", recvName, indexName, nameName)
+
+	// t.dirty&(1<<i) != 0
+	isMarked := func(index ast.Expr) ast.Expr {
+		return &ast.BinaryExpr{
+			X: &ast.BinaryExpr{
+				X:  mask,
+				Op: token.AND,
+				Y: &ast.ParenExpr{X: &ast.BinaryExpr{
+					X:  &ast.BasicLit{Kind: token.INT, Value: "1"},
+					Op: token.SHL,
+					Y:  index,
+				}},
+			},
+			Op: token.NEQ,
+			Y:  &ast.BasicLit{Kind: token.INT, Value: "0"},
+		}
+	}
+
+	var constSpecs []ast.Spec
+	var nameElts []ast.Expr
+	for _, prop := range props {
+		constName := dirtyFieldConst(typ, prop)
+		constSpecs = append(constSpecs, &ast.ValueSpec{
+			Names:  []*ast.Ident{ast.NewIdent(constName)},
+			Values: []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(prop.Name)}},
+		})
+		nameElts = append(nameElts, ast.NewIdent(constName))
+	}
+
+	result := []ast.Decl{
+		&ast.GenDecl{Tok: token.CONST, Lparen: 1, Specs: constSpecs},
+		&ast.GenDecl{Tok: token.VAR, Specs: []ast.Spec{&ast.ValueSpec{
+			Names: []*ast.Ident{ast.NewIdent(namesVar)},
+			Values: []ast.Expr{&ast.CompositeLit{
+				Type: &ast.ArrayType{Len: &ast.Ellipsis{}, Elt: ast.NewIdent("string")},
+				Elts: nameElts,
+			}},
+		}}},
+		// func (t *T) DirtyFields() []string
+		&ast.FuncDecl{
+			Recv: recv,
+			Name: ast.NewIdent("DirtyFields"),
+			Type: &ast.FuncType{
+				Params:  astkit.Fields(),
+				Results: astkit.Fields(&ast.Field{Type: &ast.ArrayType{Elt: ast.NewIdent("string")}}),
+			},
+			Body: astkit.BlockStmt(
+				&ast.DeclStmt{Decl: &ast.GenDecl{Tok: token.VAR, Specs: []ast.Spec{&ast.ValueSpec{
+					Names: []*ast.Ident{ast.NewIdent(fieldsName)},
+					Type:  &ast.ArrayType{Elt: ast.NewIdent("string")},
+				}}}},
+				&ast.RangeStmt{
+					Key:   ast.NewIdent(indexName),
+					Value: ast.NewIdent(nameName),
+					Tok:   token.DEFINE,
+					X:     ast.NewIdent(namesVar),
+					Body: astkit.BlockStmt(&ast.IfStmt{
+						Cond: isMarked(ast.NewIdent(indexName)),
+						Body: astkit.BlockStmt(astkit.AssignStmt(ast.NewIdent(fieldsName), &ast.CallExpr{
+							Fun:  ast.NewIdent("append"),
+							Args: []ast.Expr{ast.NewIdent(fieldsName), ast.NewIdent(nameName)},
+						})),
+					}),
+				},
+				astkit.ReturnStmt(ast.NewIdent(fieldsName)),
+			),
+		},
+		// func (t *T) IsDirty(name string) bool
+		&ast.FuncDecl{
+			Recv: recv,
+			Name: ast.NewIdent("IsDirty"),
+			Type: &ast.FuncType{
+				Params:  astkit.Fields(astkit.Field(ast.NewIdent(nameName), ast.NewIdent("string"))),
+				Results: astkit.Fields(&ast.Field{Type: ast.NewIdent("bool")}),
+			},
+			Body: astkit.BlockStmt(
+				&ast.AssignStmt{
+					Lhs: []ast.Expr{ast.NewIdent(indexName)},
+					Tok: token.DEFINE,
+					Rhs: []ast.Expr{&ast.CallExpr{
+						Fun: b.PkgIdent("slices", "Index"),
+						Args: []ast.Expr{
+							&ast.SliceExpr{X: ast.NewIdent(namesVar)},
+							ast.NewIdent(nameName),
+						},
+					}},
+				},
+				astkit.ReturnStmt(&ast.BinaryExpr{
+					X:  &ast.BinaryExpr{X: ast.NewIdent(indexName), Op: token.GEQ, Y: &ast.BasicLit{Kind: token.INT, Value: "0"}},
+					Op: token.LAND,
+					Y:  isMarked(ast.NewIdent(indexName)),
+				}),
+			),
+		},
+		// func (t *T) ResetDirty()
+		&ast.FuncDecl{
+			Recv: recv,
+			Name: ast.NewIdent("ResetDirty"),
+			Type: &ast.FuncType{Params: astkit.Fields()},
+			Body: astkit.BlockStmt(astkit.AssignStmt(mask, &ast.BasicLit{Kind: token.INT, Value: "0"})),
+		},
+	}
+
+	// 指定了锁字段时，读写掩码字段同样加锁
+	for _, decl := range result[2:] {
+		fn := decl.(*ast.FuncDecl)
+		b.guardFunc(typ, recvName, fn, fn.Name.Name == "ResetDirty")
+	}
+
+	setDeclsDoc(result, "\n// dirty tracking for "+typ.Name)
+	return result
+}
@@ -3,6 +3,8 @@ package lombok
 import (
 	"iter"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -77,3 +79,12 @@ func isValidIdent(s string) bool {
 
 	return true
 }
+
+// freeName 返回不与 used 中任何名称冲突的变量名，冲突时追加数字后缀
+func freeName(name string, used ...string) string {
+	newName := name
+	for i := 2; slices.Contains(used, newName); i++ {
+		newName = name + strconv.Itoa(i)
+	}
+	return newName
+}
@@ -0,0 +1,212 @@
+package lombok
+
+import (
+	"go/ast"
+)
+
+// typeKind 属性类型的分类，用于生成 Equal / Clone 等需要区分类型的代码
+type typeKind int
+
+const (
+	kindUnknown   typeKind = iota // 无法确定的类型，如其他包的命名类型、类型参数等
+	kindBasic                     // 可比较的基础类型及以其为底层类型的本包类型，如 int / string / type Status int(其他包的类型见 foreignEqualKind)
+	kindPointer                   // *T
+	kindSlice                     // []T
+	kindArray                     // [N]T
+	kindMap                       // map[K]V
+	kindStruct                    // 本包定义的 struct 类型
+	kindInterface                 // 接口类型，如 error / any / interface{...}
+	kindFunc                      // 函数类型
+	kindChan                      // chan T
+)
+
+// 可比较的内置基础类型
+var basicTypeNames = map[string]bool{
+	"bool": true, "string": true, "byte": true, "rune": true, "uintptr": true,
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"float32": true, "float64": true, "complex64": true, "complex128": true,
+}
+
+// kindOf 返回类型表达式的分类，本包定义的类型按其底层类型分类
+func (pkg *PkgInfo) kindOf(typ ast.Expr) typeKind {
+	return pkg.kindOfDepth(typ, 0)
+}
+
+func (pkg *PkgInfo) kindOfDepth(typ ast.Expr, depth int) typeKind {
+	switch x := typ.(type) {
+	case *ast.Ident:
+		if basicTypeNames[x.Name] {
+			return kindBasic
+		}
+		if x.Name == "error" || x.Name == "any" {
+			return kindInterface
+		}
+		// 本包定义的类型，按底层类型分类，depth 用于避免循环定义
+		if t := pkg.FindType(x.Name); t != nil && t.Underlying != nil && depth < 8 {
+			if _, ok := t.Underlying.(*ast.StructType); ok {
+				return kindStruct
+			}
+			return pkg.kindOfDepth(t.Underlying, depth+1)
+		}
+	case *ast.SelectorExpr:
+		// 其他包的命名类型，通过 go/types 确定底层类型为基础类型时视为基础类型，如 time.Duration
+		if pkg.foreignEqualKind(x) == equalByOperator {
+			return kindBasic
+		}
+	case *ast.ParenExpr:
+		return pkg.kindOfDepth(x.X, depth)
+	case *ast.StarExpr:
+		return kindPointer
+	case *ast.ArrayType:
+		if x.Len == nil {
+			return kindSlice
+		}
+		return kindArray
+	case *ast.MapType:
+		return kindMap
+	case *ast.StructType:
+		return kindStruct
+	case *ast.InterfaceType:
+		return kindInterface
+	case *ast.FuncType:
+		return kindFunc
+	case *ast.ChanType:
+		return kindChan
+	}
+	return kindUnknown
+}
+
+// localType 返回类型表达式对应的本包类型(忽略指针和类型参数)，非本包类型时返回 nil
+func (pkg *PkgInfo) localType(typ ast.Expr) *Type {
+	switch x := typ.(type) {
+	case *ast.Ident:
+		return pkg.FindType(x.Name)
+	case *ast.StarExpr:
+		return pkg.localType(x.X)
+	case *ast.IndexExpr:
+		return pkg.localType(x.X)
+	case *ast.IndexListExpr:
+		return pkg.localType(x.X)
+	}
+	return nil
+}
+
+// selfTypeRef 判断类型表达式是否为 t 或 *t(可带类型参数)，如手写方法的参数或返回值类型，isPtr 为是否为指针
+func selfTypeRef(t *Type, typ ast.Expr) (isPtr bool, ok bool) {
+	if star, isStar := typ.(*ast.StarExpr); isStar {
+		typ, isPtr = star.X, true
+	}
+	switch x := typ.(type) { // 泛型类型: T[K] 或 T[K, V]
+	case *ast.IndexExpr:
+		typ = x.X
+	case *ast.IndexListExpr:
+		typ = x.X
+	}
+	if ident, isIdent := typ.(*ast.Ident); isIdent && ident.Name == t.Name {
+		return isPtr, true
+	}
+	return false, false
+}
+
+// 不可复制的 sync 包类型，复制会导致 go vet copylocks 告警
+var syncNoCopyTypes = map[string]bool{
+	"Mutex": true, "RWMutex": true, "WaitGroup": true, "Once": true, "Cond": true, "Map": true, "Pool": true,
+}
+
+// isNoCopyType 判断类型是否为不可复制的 sync / sync/atomic 包类型
+func isNoCopyType(typ ast.Expr) bool {
+	pkgPath, name, ok := pkgTypeName(typ)
+	if !ok {
+		return false
+	}
+	switch pkgPath {
+	case "sync":
+		return syncNoCopyTypes[name]
+	case "sync/atomic":
+		return true
+	}
+	return false
+}
+
+// containsNoCopy 判断值类型是否不可复制: 为不可复制的 sync / sync/atomic 包类型，
+// 或为字段(递归)中包含此类类型的本包 struct 类型及其数组。指针、slice、map 等引用类型不包含在内
+func (pkg *PkgInfo) containsNoCopy(typ ast.Expr) bool {
+	return pkg.containsNoCopyVisited(typ, make(map[*Type]bool))
+}
+
+func (pkg *PkgInfo) containsNoCopyVisited(typ ast.Expr, visited map[*Type]bool) bool {
+	if isNoCopyType(typ) {
+		return true
+	}
+	switch x := typ.(type) {
+	case *ast.ArrayType:
+		return x.Len != nil && pkg.containsNoCopyVisited(x.Elt, visited)
+	case *ast.StarExpr:
+		return false
+	}
+	t := pkg.localType(typ)
+	if t == nil || visited[t] {
+		return false
+	}
+	visited[t] = true
+	for prop := range t.Properties() {
+		if pkg.containsNoCopyVisited(prop.Type, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// pkgTypeName 返回其他包类型的包路径和类型名(忽略类型参数)，如 atomic.Pointer[T] => sync/atomic, Pointer
+// 注意 scanner 已将类型表达式中的包名替换为包路径
+func pkgTypeName(typ ast.Expr) (pkgPath string, name string, ok bool) {
+	if index, isIndex := typ.(*ast.IndexExpr); isIndex {
+		typ = index.X
+	}
+	sel, isSel := typ.(*ast.SelectorExpr)
+	if !isSel {
+		return "", "", false
+	}
+	pkg, isIdent := sel.X.(*ast.Ident)
+	if !isIdent {
+		return "", "", false
+	}
+	return pkg.Name, sel.Sel.Name, true
+}
+
+// sync/atomic 包类型对应的值类型，atomic.Pointer[T] 单独处理
+var atomicValueTypes = map[string]string{
+	"Bool": "bool", "Int32": "int32", "Int64": "int64", "Uint32": "uint32", "Uint64": "uint64", "Uintptr": "uintptr", "Value": "any",
+}
+
+// atomicValueType 返回 sync/atomic 包类型 Load / Store 的值类型，如 atomic.Int64 => int64, atomic.Pointer[T] => *T
+func atomicValueType(typ ast.Expr) (ast.Expr, bool) {
+	pkgPath, name, ok := pkgTypeName(typ)
+	if !ok || pkgPath != "sync/atomic" {
+		return nil, false
+	}
+	if name == "Pointer" {
+		if index, isIndex := typ.(*ast.IndexExpr); isIndex {
+			return &ast.StarExpr{X: index.Index}, true
+		}
+		return nil, false
+	}
+	if valueType, exists := atomicValueTypes[name]; exists {
+		return ast.NewIdent(valueType), true
+	}
+	return nil, false
+}
+
+// isAtomicIntType 判断类型是否为支持 Add 操作的 sync/atomic 整数类型
+func isAtomicIntType(typ ast.Expr) bool {
+	pkgPath, name, _ := pkgTypeName(typ)
+	if pkgPath != "sync/atomic" {
+		return false
+	}
+	switch name {
+	case "Int32", "Int64", "Uint32", "Uint64", "Uintptr":
+		return true
+	}
+	return false
+}
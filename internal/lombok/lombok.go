@@ -71,6 +71,18 @@ func Clear(root string) {
 	log.Printf("处理完成. 移除文件 %d\n", deleted)
 }
 
+// Options 生成选项，作用于扫描到的所有类型
+type Options struct {
+	NilSafe bool // 所有类型生成的 getter 均在 recv 为 nil 时返回零值，等同于为每个类型添加 //lombok:nilsafe
+}
+
+// apply 将生成选项应用到包中的所有类型
+func (opts Options) apply(pkg *PkgInfo) {
+	for _, typ := range pkg.SortedTypes() {
+		typ.NilSafe = typ.NilSafe || opts.NilSafe
+	}
+}
+
 type statistic struct {
 	unchanged int
 	updated   int
@@ -78,7 +90,7 @@ type statistic struct {
 }
 
 // Generate 基于代码目录的扫描、生成、清理
-func Generate(root string, excludes []string) {
+func Generate(root string, excludes []string, opts Options) {
 	basePkg := getNameFromModFile(root)
 	fmt.Println(basePkg)
 
@@ -89,7 +101,7 @@ func Generate(root string, excludes []string) {
 			dirPkg = basePkg + dir[len(root):]
 		}
 
-		err := handlePkg(dirPkg, dir, srcFiles, &stat)
+		err := handlePkg(dirPkg, dir, srcFiles, opts, &stat)
 		if err != nil {
 			log.Fatalln(err)
 		}
@@ -119,9 +131,9 @@ func formatExcludes(root string, excludes []string) map[string]bool {
 }
 
 // 处理单个包(即单个文件夹)，不处理子包
-func handlePkg(pkgName string, dir string, srcFiles []string, stat *statistic) error {
+func handlePkg(pkgName string, dir string, srcFiles []string, opts Options, stat *statistic) error {
 	// 扫描源代码文件，生成目标代码
-	genCode, err := genPkgCode(pkgName, srcFiles)
+	genCode, err := genPkgCode(pkgName, srcFiles, opts)
 	if err != nil {
 		return err
 	}
@@ -154,12 +166,13 @@ func handlePkg(pkgName string, dir string, srcFiles []string, stat *statistic) e
 	return nil
 }
 
-func genPkgCode(pkgName string, srcFiles []string) (string, error) {
+func genPkgCode(pkgName string, srcFiles []string, opts Options) (string, error) {
 	// 扫描包信息
 	pkg, err := ScanPkgInfo(pkgName, srcFiles)
 	if err != nil {
 		return "", err
 	}
+	opts.apply(pkg)
 
 	// show pkg info
 	showPkgInfo(pkg)
@@ -229,19 +242,26 @@ func tryGuessTag(prop *Property) (string, bool) {
 		getterMode, getterTag = 2, fmt.Sprintf(`get:"%s"`, getter)
 	}
 
+	var chainPrefix string // 链式 setter 的 tag 前缀
 	if prop.ExistsSetter("Set" + ucName) {
-		setterMode, setterTag = 1, `set:""`
+		if prop.IsExistingChainSetter("Set" + ucName) {
+			chainPrefix = "!"
+		}
+		setterMode, setterTag = 1, fmt.Sprintf(`set:"%s"`, chainPrefix)
 	} else if setter, ok := firstOf(prop.ExistingSetters()); ok {
-		setterMode, setterTag = 2, fmt.Sprintf(`set:"%s"`, setter)
+		if prop.IsExistingChainSetter(setter) {
+			chainPrefix = "!"
+		}
+		setterMode, setterTag = 2, fmt.Sprintf(`set:"%s%s"`, chainPrefix, setter)
 	}
 
 	var tag string
 	if getterMode == 0 && setterMode == 0 {
 		return "", false
 	} else if getterMode == 1 && setterMode == 1 {
-		tag = `prop:""`
+		tag = fmt.Sprintf(`prop:"%s"`, chainPrefix)
 	} else if getterMode == 3 && setterMode == 1 {
-		tag = `prop:"@"`
+		tag = fmt.Sprintf(`prop:"%s@"`, chainPrefix)
 	} else {
 		if getterTag == "" {
 			tag = setterTag
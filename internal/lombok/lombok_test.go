@@ -11,18 +11,208 @@ var genTest1Code string
 //go:embed testdata/test_1.properties.go
 var genTest1Expected string
 
+//go:embed testdata/test_2.go
+var genTest2Code string
+
+//go:embed testdata/test_2.properties.go
+var genTest2Expected string
+
+//go:embed testdata/test_3.go
+var genTest3Code string
+
+//go:embed testdata/test_3.properties.go
+var genTest3Expected string
+
+//go:embed testdata/test_4.go
+var genTest4Code string
+
+//go:embed testdata/test_4.properties.go
+var genTest4Expected string
+
+//go:embed testdata/test_5.go
+var genTest5Code string
+
+//go:embed testdata/test_5.properties.go
+var genTest5Expected string
+
+//go:embed testdata/test_6.go
+var genTest6Code string
+
+//go:embed testdata/test_6.properties.go
+var genTest6Expected string
+
+//go:embed testdata/test_7.go
+var genTest7Code string
+
+//go:embed testdata/test_7.properties.go
+var genTest7Expected string
+
+//go:embed testdata/test_8.go
+var genTest8Code string
+
+//go:embed testdata/test_8.properties.go
+var genTest8Expected string
+
+//go:embed testdata/test_9.go
+var genTest9Code string
+
+//go:embed testdata/test_9.properties.go
+var genTest9Expected string
+
+//go:embed testdata/test_10.go
+var genTest10Code string
+
+//go:embed testdata/test_10.properties.go
+var genTest10Expected string
+
+//go:embed testdata/test_11.go
+var genTest11Code string
+
+//go:embed testdata/test_11.properties.go
+var genTest11Expected string
+
+//go:embed testdata/test_12.go
+var genTest12Code string
+
+//go:embed testdata/test_12.properties.go
+var genTest12Expected string
+
+//go:embed testdata/test_13.go
+var genTest13Code string
+
+//go:embed testdata/test_13.properties.go
+var genTest13Expected string
+
+//go:embed testdata/test_14.go
+var genTest14Code string
+
+//go:embed testdata/test_14.properties.go
+var genTest14Expected string
+
+//go:embed testdata/test_15.go
+var genTest15Code string
+
+//go:embed testdata/test_15.properties.go
+var genTest15Expected string
+
+//go:embed testdata/test_16.go
+var genTest16Code string
+
+//go:embed testdata/test_16.properties.go
+var genTest16Expected string
+
+//go:embed testdata/test_17.go
+var genTest17Code string
+
+//go:embed testdata/test_17.properties.go
+var genTest17Expected string
+
+//go:embed testdata/test_18.go
+var genTest18Code string
+
+//go:embed testdata/test_18.properties.go
+var genTest18Expected string
+
+//go:embed testdata/test_19.go
+var genTest19Code string
+
+//go:embed testdata/test_19.properties.go
+var genTest19Expected string
+
+//go:embed testdata/test_20.go
+var genTest20Code string
+
+//go:embed testdata/test_20.properties.go
+var genTest20Expected string
+
+//go:embed testdata/test_21.go
+var genTest21Code string
+
+//go:embed testdata/test_21.properties.go
+var genTest21Expected string
+
+//go:embed testdata/test_22.go
+var genTest22Code string
+
+//go:embed testdata/test_22.properties.go
+var genTest22Expected string
+
+//go:embed testdata/test_23.go
+var genTest23Code string
+
+//go:embed testdata/test_23.properties.go
+var genTest23Expected string
+
+//go:embed testdata/test_24.go
+var genTest24Code string
+
+//go:embed testdata/test_24.properties.go
+var genTest24Expected string
+
+//go:embed testdata/test_25.go
+var genTest25Code string
+
+//go:embed testdata/test_25.properties.go
+var genTest25Expected string
+
+//go:embed testdata/test_26.go
+var genTest26Code string
+
+//go:embed testdata/test_26.properties.go
+var genTest26Expected string
+
+//go:embed testdata/test_27.go
+var genTest27Code string
+
+//go:embed testdata/test_27.properties.go
+var genTest27Expected string
+
 func TestGenerateByCode(t *testing.T) {
 	var pkgName = "testdata"
-	var code = genTest1Code
-	var expected = genTest1Expected
-
-	result, err := GenerateByCode(pkgName, code)
-	if err != nil {
-		t.Errorf("GenerateByCode() error = %v", err)
-		return
+	tests := []struct {
+		name     string
+		code     string
+		expected string
+	}{
+		{name: "test_1", code: genTest1Code, expected: genTest1Expected},
+		{name: "test_2", code: genTest2Code, expected: genTest2Expected},
+		{name: "test_3", code: genTest3Code, expected: genTest3Expected},
+		{name: "test_4", code: genTest4Code, expected: genTest4Expected},
+		{name: "test_5", code: genTest5Code, expected: genTest5Expected},
+		{name: "test_6", code: genTest6Code, expected: genTest6Expected},
+		{name: "test_7", code: genTest7Code, expected: genTest7Expected},
+		{name: "test_8", code: genTest8Code, expected: genTest8Expected},
+		{name: "test_9", code: genTest9Code, expected: genTest9Expected},
+		{name: "test_10", code: genTest10Code, expected: genTest10Expected},
+		{name: "test_11", code: genTest11Code, expected: genTest11Expected},
+		{name: "test_12", code: genTest12Code, expected: genTest12Expected},
+		{name: "test_13", code: genTest13Code, expected: genTest13Expected},
+		{name: "test_14", code: genTest14Code, expected: genTest14Expected},
+		{name: "test_15", code: genTest15Code, expected: genTest15Expected},
+		{name: "test_16", code: genTest16Code, expected: genTest16Expected},
+		{name: "test_17", code: genTest17Code, expected: genTest17Expected},
+		{name: "test_18", code: genTest18Code, expected: genTest18Expected},
+		{name: "test_19", code: genTest19Code, expected: genTest19Expected},
+		{name: "test_20", code: genTest20Code, expected: genTest20Expected},
+		{name: "test_21", code: genTest21Code, expected: genTest21Expected},
+		{name: "test_22", code: genTest22Code, expected: genTest22Expected},
+		{name: "test_23", code: genTest23Code, expected: genTest23Expected},
+		{name: "test_24", code: genTest24Code, expected: genTest24Expected},
+		{name: "test_25", code: genTest25Code, expected: genTest25Expected},
+		{name: "test_26", code: genTest26Code, expected: genTest26Expected},
+		{name: "test_27", code: genTest27Code, expected: genTest27Expected},
 	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := GenerateByCode(pkgName, test.code)
+			if err != nil {
+				t.Errorf("GenerateByCode() error = %v", err)
+				return
+			}
 
-	if result != expected {
-		t.Errorf("GenerateByCode() = %v, want %v", result, expected)
+			if result != test.expected {
+				t.Errorf("GenerateByCode() = %v, want %v", result, test.expected)
+			}
+		})
 	}
 }
@@ -1,12 +1,15 @@
 package lombok
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"reflect"
+	"slices"
 	"strings"
 )
 
@@ -20,6 +23,9 @@ func ScanPkgInfo(pkg string, srcFiles []string) (*PkgInfo, error) {
 			return nil, err
 		}
 	}
+	if err := sc.checkPkg(); err != nil {
+		return nil, err
+	}
 	return sc.pkg, nil
 }
 
@@ -29,6 +35,9 @@ func ScanCode(pkg string, code string) (*PkgInfo, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := sc.checkPkg(); err != nil {
+		return nil, err
+	}
 	return sc.pkg, nil
 }
 
@@ -36,6 +45,16 @@ type scanner struct {
 	pkg     *PkgInfo
 	imports map[string]string
 	errors  []error
+	sources []source // 已扫描的源码，用于需要 go/types 类型检查时重新解析
+
+	typesPkg *types.Package // go/types 类型检查结果，见 typeCheck
+	typesErr error          // go/types 类型检查的导入错误
+}
+
+// source 源码文件，code 为 nil 时从 file 读取
+type source struct {
+	file string
+	code any
 }
 
 func newScanner(pkg string) *scanner {
@@ -49,6 +68,7 @@ func (sc *scanner) scanFile(file string) error {
 	if err != nil {
 		return err
 	}
+	sc.sources = append(sc.sources, source{file: file})
 
 	return sc.scanAstFile(astFile)
 }
@@ -58,6 +78,7 @@ func (sc *scanner) scanFileCode(code string) error {
 	if err != nil {
 		return err
 	}
+	sc.sources = append(sc.sources, source{code: code})
 
 	return sc.scanAstFile(astFile)
 }
@@ -85,6 +106,180 @@ func (sc *scanner) scanAstFile(astFile *ast.File) error {
 	return errors.Join(sc.errors...)
 }
 
+// checkPkg 在包内所有文件扫描完成后检查依赖其他文件内容的配置，如 tag 引用的方法是否存在
+func (sc *scanner) checkPkg() error {
+	sc.skipExistingDirectiveAccessors()
+	sc.resolveDelegates()
+	sc.resolveEnums()
+	sc.resolveForeignEquals()
+	sc.checkOptions()
+	sc.checkBuilders()
+	for _, typ := range sc.pkg.SortedTypes() {
+		sc.resolveValueRecv(typ)
+		sc.checkClone(typ)
+		sc.checkWithers(typ)
+		for prop := range typ.Properties() {
+			for _, hook := range []string{prop.SetValidator, prop.AfterSetHook, prop.LazyLoader} {
+				if hook != "" && !typ.ExistsMethod(hook) {
+					sc.addError(fmt.Errorf("类型 %s 的 %s 属性引用的方法 %s 不存在", typ.Name, prop.Name, hook))
+				}
+			}
+			if prop.Default != "" {
+				sc.addError(sc.checkDefault(typ, prop))
+			}
+		}
+	}
+	return errors.Join(sc.errors...)
+}
+
+// skipExistingDirectiveAccessors 跳过类型指令默认生成、但类型已存在同名方法的 getter / setter
+// 已有方法可能定义在包内的其他文件中，需在所有文件扫描完成后处理；tag 显式指定的方法名不受影响
+func (sc *scanner) skipExistingDirectiveAccessors() {
+	for _, typ := range sc.pkg.SortedTypes() {
+		for prop := range typ.Properties() {
+			if prop.directiveGetter && typ.ExistsMethod(prop.Getter) {
+				prop.Getter = ""
+			}
+			if prop.directiveSetter && typ.ExistsMethod(prop.Setter) {
+				prop.Setter = ""
+			}
+		}
+	}
+}
+
+// resolveValueRecv 确定类型生成的属性方法是否使用值接收者:
+// recv tag 指定 value 时使用值接收者，存在无法用于值接收者的属性方法时报错；
+// 未指定时，若类型已有的方法均为值接收者，且没有无法用于值接收者的属性方法，则使用值接收者
+func (sc *scanner) resolveValueRecv(typ *Type) {
+	switch typ.RecvKind {
+	case "value":
+		if reason := valueRecvConflict(sc.pkg, typ); reason != "" {
+			sc.addError(fmt.Errorf("类型 %s 使用值接收者，%s", typ.Name, reason))
+			return
+		}
+		typ.ValueRecv = true
+	case "":
+		typ.ValueRecv = typ.existsValueRecv && !typ.existsPtrRecv && valueRecvConflict(sc.pkg, typ) == ""
+	}
+}
+
+// valueRecvConflict 返回类型无法使用值接收者的原因，可以使用时返回空字符串
+func valueRecvConflict(pkg *PkgInfo, typ *Type) string {
+	if typ.NilSafe {
+		return "不可生成 nilsafe getter"
+	}
+	if typ.Guard != "" {
+		return "不可使用 guard 加锁(复制 recv 会复制锁)"
+	}
+	if typ.DirtyField != "" {
+		return "不可使用 track 记录脏字段(修改的是 recv 的副本)"
+	}
+	if typ.Options {
+		return "不可生成函数式选项(applyOptions 修改的是 recv 的副本)"
+	}
+	for _, m := range []struct {
+		name    string
+		enabled bool
+	}{{"Equal", typ.Equal}, {"Hash", typ.Hash}, {"Clone", typ.Clone}} {
+		if m.enabled && !typ.ExistsMethod(m.name) {
+			return fmt.Sprintf("不可生成 %s 方法(使用指针接收者，值类型可直接使用 == 比较或赋值复制)", m.name)
+		}
+	}
+	for prop := range typ.Properties() {
+		switch {
+		case pkg.containsNoCopy(prop.Type): // 含字段中包含锁的本包 struct 类型
+			return fmt.Sprintf("不可包含不可复制的字段 %s", prop.Name)
+		case !prop.HasAccessor():
+		case prop.Setter != "":
+			return fmt.Sprintf("不可生成 %s 属性的 setter(修改的是 recv 的副本，不会生效)", prop.Name)
+		case prop.IsRefGetter:
+			return fmt.Sprintf("不可生成 %s 属性的引用 getter(返回的是 recv 副本的字段地址)", prop.Name)
+		case prop.LazyLoader != "":
+			return fmt.Sprintf("不可生成 %s 属性的延迟加载 getter", prop.Name)
+		case prop.Coll != "":
+			return fmt.Sprintf("不可生成 %s 属性的集合辅助方法", prop.Name)
+		case prop.Delegate:
+			return fmt.Sprintf("不可生成 %s 属性的 delegate 方法", prop.Name)
+		}
+	}
+	return ""
+}
+
+// checkClone 检查生成 Clone 的类型是否包含无法复制状态的 sync 类型字段，或类型中包含此类字段的 struct 字段
+// sync.Mutex / sync.RWMutex 在副本中为未加锁的新锁，sync/atomic 类型通过 Load / Store 复制值
+func (sc *scanner) checkClone(typ *Type) {
+	if !typ.Clone || typ.ExistsMethod("Clone") {
+		return
+	}
+	for prop := range typ.Properties() {
+		if pkgPath, name, _ := pkgTypeName(prop.Type); pkgPath == "sync" && syncNoCopyTypes[name] && name != "Mutex" && name != "RWMutex" {
+			sc.addError(fmt.Errorf("类型 %s 的 %s 字段为 sync.%s 类型，无法复制其状态，不可生成 Clone 方法", typ.Name, prop.Name, name))
+		} else if !isNoCopyType(prop.Type) && sc.pkg.containsNoCopy(prop.Type) {
+			sc.addError(fmt.Errorf("类型 %s 的 %s 字段的类型包含 sync 等不可复制的字段，不可生成 Clone 方法(可改为指针字段)", typ.Name, prop.Name))
+		}
+	}
+}
+
+// checkBuilders 检查 Builder 类型名及其方法名是否冲突: 已存在同名类型，或属性的流式方法与 Build 方法、其他属性的流式方法重名
+func (sc *scanner) checkBuilders() {
+	for _, typ := range sc.pkg.SortedTypes() {
+		if !typ.Builder {
+			continue
+		}
+		builderName := typ.Name + "Builder"
+		if sc.pkg.FindType(builderName) != nil {
+			sc.addError(fmt.Errorf("类型 %s 的 Builder 类型 %s 已存在", typ.Name, builderName))
+			continue
+		}
+		methodOwners := map[string]string{"Build": ""} // 方法名 => 属性名
+		for _, prop := range constructProperties(typ) {
+			name := pascalCase(prop.Name)
+			if other, exists := methodOwners[name]; exists {
+				if other == "" {
+					other = "Build 方法"
+				} else {
+					other += " 属性"
+				}
+				sc.addError(fmt.Errorf("类型 %s 的 %s 属性的 Builder 方法 %s 与 %s冲突", typ.Name, prop.Name, name, other))
+				continue
+			}
+			methodOwners[name] = prop.Name
+		}
+	}
+}
+
+// checkOptions 检查不同类型生成的函数式选项函数名是否冲突，已存在的同名函数不生成，不视为冲突
+func (sc *scanner) checkOptions() {
+	funcOwners := make(map[string]string) // 函数名 => 类型名
+	for _, typ := range sc.pkg.SortedTypes() {
+		if !typ.Options {
+			continue
+		}
+		for _, prop := range constructProperties(typ) {
+			name := optionFuncName(typ, prop)
+			if sc.pkg.ExistsFunc(name) {
+				continue
+			}
+			if other, exists := funcOwners[name]; exists {
+				sc.addError(fmt.Errorf("类型 %s 与 %s 的函数式选项 %s 冲突，可通过 options 前缀区分", other, typ.Name, name))
+				continue
+			}
+			funcOwners[name] = typ.Name
+		}
+	}
+}
+
+// checkDefault 检查 default tag 值与属性类型是否匹配，需在所有文件扫描完成后检查以确定本包类型的底层类型
+func (sc *scanner) checkDefault(typ *Type, prop *Property) error {
+	if prop.Getter == "" || prop.IsRefGetter || prop.LazyLoader != "" {
+		return fmt.Errorf("类型 %s 的 %s 属性的 default 需与非引用、非延迟加载的 getter 同时使用", typ.Name, prop.Name)
+	}
+	if _, err := sc.pkg.parseDefault(prop.Type, prop.Default); err != nil {
+		return fmt.Errorf("类型 %s 的 %s 属性 tag 解析异常: %w", typ.Name, prop.Name, err)
+	}
+	return nil
+}
+
 func (sc *scanner) addError(err error) {
 	if err != nil {
 		sc.errors = append(sc.errors, err)
@@ -93,6 +288,13 @@ func (sc *scanner) addError(err error) {
 
 func (sc *scanner) inspectNode(node ast.Node) bool {
 	switch x := node.(type) {
+	case *ast.GenDecl:
+		// 单个类型定义时注释位于 GenDecl 上，转移到 TypeSpec 以便读取指令
+		if x.Tok == token.TYPE && len(x.Specs) == 1 {
+			if typeSpec, ok := x.Specs[0].(*ast.TypeSpec); ok && typeSpec.Doc == nil {
+				typeSpec.Doc = x.Doc
+			}
+		}
 	case *ast.TypeSpec:
 		sc.inspectTypeSpec(x)
 	case *ast.FuncDecl:
@@ -104,28 +306,227 @@ func (sc *scanner) inspectNode(node ast.Node) bool {
 // 分析 struct 类型定义获取属性信息
 
 func (sc *scanner) inspectTypeSpec(typeSpec *ast.TypeSpec) {
+	typeName := typeSpec.Name.Name
 	structType, ok := typeSpec.Type.(*ast.StructType)
 	if !ok {
+		// 非 struct 类型仅记录底层类型，用于判断属性类型的分类；//lombok:enum 指令标记的整数类型生成枚举辅助方法
+		typ := sc.pkg.FindOrInitType(typeName)
+		typ.Underlying = typeSpec.Type
+		for _, d := range parseDirectives(typeSpec.Doc) {
+			if d.Name == "enum" {
+				typ.Enum = true
+				typ.EnumTrimPrefix = d.Args
+			}
+		}
 		return
 	}
 
-	typeName := typeSpec.Name.Name
 	typ := sc.pkg.FindOrInitType(typeName)
+	typ.Underlying = structType
+	if typeSpec.TypeParams != nil {
+		for _, field := range typeSpec.TypeParams.List {
+			field.Type = sc.resolveType(field.Type)
+		}
+		typ.TypeParams = typeSpec.TypeParams
+	}
+
+	var td typeDirectives
+	for _, d := range parseDirectives(typeSpec.Doc) {
+		err := sc.applyTypeDirective(typ, &td, d)
+		if err != nil {
+			sc.addError(fmt.Errorf("类型 %s 的指令解析异常: %w", typeName, err))
+		}
+	}
 
 	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 { // 嵌入字段，属性名取自类型名
+			name, ok := embeddedFieldName(field.Type)
+			if !ok {
+				continue
+			}
+			prop := typ.AddProperty(name)
+			prop.Embedded = true
+			sc.inspectField(typ, prop, field)
+			continue
+		}
+
 		for _, name := range field.Names {
 			prop := typ.AddProperty(name.Name)
-			prop.Type = sc.resolveType(field.Type)
-			if field.Tag != nil {
-				err := sc.parsePropertyTag(typ, prop, field.Tag.Value)
-				if err != nil {
-					sc.addError(fmt.Errorf("类型 %s 的 %s 属性 tag 解析异常: %w", typeName, prop.Name, err))
-				}
+			sc.inspectField(typ, prop, field)
+		}
+	}
+
+	td.applyFieldDefaults(typ)
+	sc.checkAccessorConflicts(typ)
+	sc.checkDirtyField(typ)
+	sc.checkLazyOnceFields(typ)
+	sc.checkGuardField(typ)
+}
+
+func (sc *scanner) inspectField(typ *Type, prop *Property, field *ast.Field) {
+	prop.Type = sc.resolveType(field.Type)
+	for _, d := range parseDirectives(field.Doc, field.Comment) {
+		err := sc.applyFieldDirective(prop, d)
+		if err != nil {
+			sc.addError(fmt.Errorf("类型 %s 的 %s 属性指令解析异常: %w", typ.Name, prop.Name, err))
+		}
+	}
+	if field.Tag != nil {
+		err := sc.parsePropertyTag(typ, prop, field.Tag.Value)
+		if err != nil {
+			sc.addError(fmt.Errorf("类型 %s 的 %s 属性 tag 解析异常: %w", typ.Name, prop.Name, err))
+		}
+	}
+}
+
+// 检查生成的 getter/setter/wither 是否与字段重名，Go 不允许同一类型下字段与方法同名
+func (sc *scanner) checkAccessorConflicts(typ *Type) {
+	for prop := range typ.Properties() {
+		methods := append([]string{prop.Getter, prop.Setter, prop.Wither, prop.AtomicAdder, prop.AtomicCAS, prop.Iter}, collMethodNames(prop)...)
+		for _, method := range methods {
+			if method != "" && slices.Contains(typ.propertyNames, method) {
+				sc.addError(fmt.Errorf("类型 %s 的 %s 属性生成的方法 %s 与字段重名", typ.Name, prop.Name, method))
 			}
 		}
 	}
 }
 
+// 检查延迟加载 getter 引用的 sync.Once 字段是否存在
+// 首次访问时的加载会覆盖 setter 等写入的值，且加载不持有 guard 的锁，延迟加载的属性不可修改也不可使用 guard
+func (sc *scanner) checkLazyOnceFields(typ *Type) {
+	for prop := range typ.Properties() {
+		if prop.LazyOnce == "" {
+			continue
+		}
+		if prop.Setter != "" || prop.Coll != "" {
+			sc.addError(fmt.Errorf("类型 %s 的 %s 属性为延迟加载，不可生成 setter 或集合辅助方法(首次访问时的加载会覆盖写入的值)", typ.Name, prop.Name))
+		}
+		if typ.Guard != "" {
+			sc.addError(fmt.Errorf("类型 %s 的 %s 属性为延迟加载，不可与 guard 同时使用(加载时不持有锁)", typ.Name, prop.Name))
+		}
+		onceProp := typ.FindProperty(prop.LazyOnce)
+		if onceProp == nil || !slices.Contains(typ.propertyNames, prop.LazyOnce) {
+			sc.addError(fmt.Errorf("类型 %s 的 %s 属性引用的 sync.Once 字段 %s 不存在", typ.Name, prop.Name, prop.LazyOnce))
+			continue
+		}
+		if pkgPath, name, _ := pkgTypeName(onceProp.Type); pkgPath != "sync" || name != "Once" {
+			sc.addError(fmt.Errorf("类型 %s 的 %s 属性引用的字段 %s 必须为 sync.Once 类型", typ.Name, prop.Name, prop.LazyOnce))
+		}
+	}
+}
+
+// 检查 guard 引用的锁字段是否存在且为 sync.Mutex / sync.RWMutex 类型(或其指针)
+func (sc *scanner) checkGuardField(typ *Type) {
+	if typ.Guard == "" {
+		return
+	}
+
+	guardProp := typ.FindProperty(typ.Guard)
+	if guardProp == nil || !slices.Contains(typ.propertyNames, typ.Guard) {
+		sc.addError(fmt.Errorf("类型 %s 的锁字段 %s 不存在", typ.Name, typ.Guard))
+		return
+	}
+	if _, ok := guardLockKind(guardProp.Type); !ok {
+		sc.addError(fmt.Errorf("类型 %s 的锁字段 %s 必须为 sync.Mutex 或 sync.RWMutex 类型", typ.Name, typ.Guard))
+		return
+	}
+
+	// 锁字段不参与 String / Equal / Hash
+	guardProp.StringExcluded = true
+	guardProp.EqualExcluded = true
+}
+
+// 检查 wither 所在类型是否包含不可复制的字段(含字段中包含锁的本包 struct 类型)，wither 需要复制整个对象
+// 字段类型可能定义在包内的其他文件中，需在所有文件扫描完成后检查
+func (sc *scanner) checkWithers(typ *Type) {
+	var noCopyProp *Property
+	for prop := range typ.Properties() {
+		if sc.pkg.containsNoCopy(prop.Type) {
+			noCopyProp = prop
+			break
+		}
+	}
+	if noCopyProp == nil {
+		return
+	}
+	for prop := range typ.Properties() {
+		if prop.Wither != "" {
+			sc.addError(fmt.Errorf("类型 %s 的 %s 属性不可生成 wither: 类型包含不可复制的字段 %s", typ.Name, prop.Name, noCopyProp.Name))
+		}
+	}
+}
+
+// guardLockKind 判断锁字段类型，返回是否为读写锁
+func guardLockKind(typ ast.Expr) (isRW bool, ok bool) {
+	if star, isStar := typ.(*ast.StarExpr); isStar {
+		typ = star.X
+	}
+	pkgPath, name, _ := pkgTypeName(typ)
+	if pkgPath != "sync" {
+		return false, false
+	}
+	switch name {
+	case "Mutex":
+		return false, true
+	case "RWMutex":
+		return true, true
+	}
+	return false, false
+}
+
+// 掩码字段类型及其可跟踪的字段数
+var dirtyMaskBits = map[string]int{"uint8": 8, "uint16": 16, "uint32": 32, "uint64": 64}
+
+// 检查脏字段跟踪的掩码字段是否存在且位数足够
+func (sc *scanner) checkDirtyField(typ *Type) {
+	if typ.DirtyField == "" {
+		return
+	}
+
+	maskProp := typ.FindProperty(typ.DirtyField)
+	if maskProp == nil || !slices.Contains(typ.propertyNames, typ.DirtyField) {
+		sc.addError(fmt.Errorf("类型 %s 的脏字段掩码字段 %s 不存在", typ.Name, typ.DirtyField))
+		return
+	}
+	ident, _ := maskProp.Type.(*ast.Ident)
+	if ident == nil || dirtyMaskBits[ident.Name] == 0 {
+		sc.addError(fmt.Errorf("类型 %s 的脏字段掩码字段 %s 必须为 uint8/uint16/uint32/uint64 类型", typ.Name, typ.DirtyField))
+		return
+	}
+	if n := len(trackedProperties(typ)); n > dirtyMaskBits[ident.Name] {
+		sc.addError(fmt.Errorf("类型 %s 的跟踪字段数 %d 超过掩码字段 %s 的位数", typ.Name, n, typ.DirtyField))
+	}
+	// sync/atomic 属性的方法不加锁，并发标记掩码字段会产生数据竞争，需通过 guard 加锁
+	if typ.Guard == "" {
+		for _, prop := range trackedProperties(typ) {
+			if _, isAtomic := atomicValueType(prop.Type); isAtomic {
+				sc.addError(fmt.Errorf("类型 %s 的 %s 属性为 sync/atomic 类型，标记脏字段掩码不是原子操作，track 需与 guard 同时使用", typ.Name, prop.Name))
+			}
+		}
+	}
+
+	// 掩码字段属于元数据，不参与 String / Equal / Hash
+	maskProp.StringExcluded = true
+	maskProp.EqualExcluded = true
+}
+
+// embeddedFieldName 返回嵌入字段的字段名，即去除指针、包名、类型参数后的类型名
+func embeddedFieldName(typ ast.Expr) (string, bool) {
+	switch x := typ.(type) {
+	case *ast.Ident: // T
+		return x.Name, true
+	case *ast.StarExpr: // *T
+		return embeddedFieldName(x.X)
+	case *ast.SelectorExpr: // p.T
+		return x.Sel.Name, true
+	case *ast.IndexExpr: // T[K]
+		return embeddedFieldName(x.X)
+	case *ast.IndexListExpr: // T[K, V]
+		return embeddedFieldName(x.X)
+	}
+	return "", false
+}
+
 func (sc *scanner) parsePropertyTag(typ *Type, prop *Property, tagStr string) error {
 	prop.Tag = tagStr
 
@@ -136,7 +537,77 @@ func (sc *scanner) parsePropertyTag(typ *Type, prop *Property, tagStr string) er
 	// getter/setter from tag
 	tag := reflect.StructTag(strings.Trim(tagStr, "`"))
 	if recvVal, ok := tag.Lookup("recv"); ok {
-		typ.RecvName = recvVal
+		err := sc.parseRecvTag(typ, recvVal)
+		if err != nil {
+			return err
+		}
+	}
+	if _, ok := tag.Lookup("builder"); ok {
+		typ.Builder = true
+	}
+	if _, ok := tag.Lookup("nilsafe"); ok {
+		typ.NilSafe = true
+	}
+	if _, ok := tag.Lookup("iface"); ok {
+		typ.Interface = true
+	}
+	if tagVal, ok := tag.Lookup("options"); ok {
+		if tagVal != "" && !isValidIdent(tagVal) {
+			return fmt.Errorf(`错误的 options 值 "%s"`, tagVal)
+		}
+		typ.Options, typ.OptionsPrefix = true, tagVal
+	}
+	if _, ok := tag.Lookup("required"); ok {
+		prop.Required = true
+	}
+	if tagVal, ok := tag.Lookup("tostring"); ok {
+		typ.ToString = true
+		switch tagVal {
+		case "":
+		case "-":
+			prop.StringExcluded = true
+		case "sensitive":
+			prop.Sensitive = true
+		default:
+			return fmt.Errorf(`错误的 tostring 值 "%s"`, tagVal)
+		}
+	}
+	if tagVal, ok := tag.Lookup("equal"); ok {
+		typ.Equal = true
+		switch tagVal {
+		case "":
+		case "hash":
+			typ.Hash = true
+		case "-":
+			prop.EqualExcluded = true
+		default:
+			return fmt.Errorf(`错误的 equal 值 "%s"`, tagVal)
+		}
+	}
+	if tagVal, ok := tag.Lookup("clone"); ok {
+		typ.Clone = true
+		switch tagVal {
+		case "":
+		case "shallow":
+			prop.ShallowClone = true
+		default:
+			return fmt.Errorf(`错误的 clone 值 "%s"`, tagVal)
+		}
+	}
+	if tagVal, ok := tag.Lookup("guard"); ok {
+		if !isValidIdent(tagVal) {
+			return fmt.Errorf(`错误的 guard 值 "%s"`, tagVal)
+		}
+		typ.Guard = tagVal
+	}
+	if tagVal, ok := tag.Lookup("track"); ok {
+		typ.DirtyField = cmp.Or(tagVal, defaultDirtyField)
+	}
+	if ctorVal, ok := tag.Lookup("ctor"); ok {
+		err := sc.parseCtorTag(typ, ctorVal)
+		if err != nil {
+			return err
+		}
 	}
 
 	var hasGetTag, hasSetTag bool
@@ -155,6 +626,44 @@ func (sc *scanner) parsePropertyTag(typ *Type, prop *Property, tagStr string) er
 		}
 	}
 
+	if tagVal, ok := tag.Lookup("with"); ok {
+		err := sc.parseWithTag(prop, tagVal)
+		if err != nil {
+			return err
+		}
+	}
+
+	if tagVal, ok := tag.Lookup("default"); ok {
+		if tagVal == "" {
+			return errors.New("default 值不可为空")
+		}
+		prop.Default = tagVal
+	}
+
+	if tagVal, ok := tag.Lookup("delegate"); ok {
+		if tagVal != "" {
+			return fmt.Errorf(`错误的 delegate 值 "%s"`, tagVal)
+		}
+		if prop.Embedded {
+			return errors.New("嵌入字段的方法已自动提升，无需 delegate")
+		}
+		prop.Delegate = true
+	}
+
+	if tagVal, ok := tag.Lookup("coll"); ok {
+		err := sc.parseCollTag(prop, tagVal)
+		if err != nil {
+			return err
+		}
+	}
+
+	if tagVal, ok := tag.Lookup("iter"); ok {
+		err := sc.parseIterTag(prop, tagVal)
+		if err != nil {
+			return err
+		}
+	}
+
 	if tagVal, ok := tag.Lookup("prop"); ok {
 		if hasGetTag || hasSetTag {
 			return errors.New("prop 不可与 get 或 set 同时使用")
@@ -181,14 +690,86 @@ func (sc *scanner) resolveType(typ ast.Expr) ast.Expr {
 	case *ast.IndexExpr: // T1[T2]
 		x.X = sc.resolveType(x.X)
 		x.Index = sc.resolveType(x.Index)
+	case *ast.IndexListExpr: // T1[T2, T3]
+		x.X = sc.resolveType(x.X)
+		for i, index := range x.Indices {
+			x.Indices[i] = sc.resolveType(index)
+		}
 	case *ast.ArrayType:
 		x.Elt = sc.resolveType(x.Elt)
+	case *ast.MapType:
+		x.Key = sc.resolveType(x.Key)
+		x.Value = sc.resolveType(x.Value)
+	case *ast.ChanType:
+		x.Value = sc.resolveType(x.Value)
+	case *ast.FuncType:
+		sc.resolveFieldTypes(x.Params)
+		sc.resolveFieldTypes(x.Results)
+	case *ast.InterfaceType: // 类型约束或接口类型，如 interface{ fmt.Stringer }
+		sc.resolveFieldTypes(x.Methods)
+	case *ast.BinaryExpr: // 类型约束的联合，如 ~int | time.Duration
+		x.X = sc.resolveType(x.X)
+		x.Y = sc.resolveType(x.Y)
+	case *ast.UnaryExpr: // 类型约束的近似元素，如 ~int
+		x.X = sc.resolveType(x.X)
+	case *ast.ParenExpr:
+		x.X = sc.resolveType(x.X)
 	}
 	return typ
 }
 
+func (sc *scanner) resolveFieldTypes(fields *ast.FieldList) {
+	if fields == nil {
+		return
+	}
+	for _, field := range fields.List {
+		field.Type = sc.resolveType(field.Type)
+	}
+}
+
+// parseRecvTag 解析 recv tag，值为 recv 名及可选的接收者类型，如 "v" / "v,value" / ",pointer"
+func (sc *scanner) parseRecvTag(typ *Type, tagVal string) error {
+	name, options := splitTagOptions(tagVal)
+	if name != "" && !isValidIdent(name) {
+		return fmt.Errorf(`错误的 recv 值 "%s"`, tagVal)
+	}
+	typ.RecvName = name
+	for key := range options {
+		switch key {
+		case "value", "pointer":
+			if typ.RecvKind != "" && typ.RecvKind != key {
+				return fmt.Errorf(`错误的 recv 值 "%s": value 与 pointer 不可同时使用`, tagVal)
+			}
+			typ.RecvKind = key
+		default:
+			return fmt.Errorf(`错误的 recv 选项 "%s"`, key)
+		}
+	}
+	return nil
+}
+
+// ctor 值为逗号分隔的构造函数类型: all / required，空值等价于 all
+func (sc *scanner) parseCtorTag(typ *Type, tagVal string) error {
+	if tagVal == "" {
+		typ.AllArgsCtor = true
+		return nil
+	}
+	for _, item := range strings.Split(tagVal, ",") {
+		switch strings.TrimSpace(item) {
+		case "all":
+			typ.AllArgsCtor = true
+		case "required":
+			typ.RequiredArgsCtor = true
+		default:
+			return fmt.Errorf(`错误的 ctor 值 "%s"`, tagVal)
+		}
+	}
+	return nil
+}
+
 func (sc *scanner) parseGetTag(prop *Property, tagVal string) error {
 	rawTagVal := tagVal
+	tagVal, options := splitTagOptions(tagVal)
 	if strings.HasPrefix(tagVal, "&") {
 		prop.IsRefGetter = true
 		tagVal = tagVal[1:]
@@ -204,27 +785,171 @@ func (sc *scanner) parseGetTag(prop *Property, tagVal string) error {
 		}
 		prop.Getter = tagVal
 	}
+
+	// 选项: lazy=加载方法名 / once=sync.Once 字段名(省略时使用默认名)，copy 返回副本
+	for key, value := range options {
+		switch key {
+		case "lazy":
+			prop.LazyLoader = cmp.Or(value, "load"+pascalCase(prop.Name))
+		case "once":
+			prop.LazyOnce = value
+		case "copy":
+			prop.CopyGetter = true
+		default:
+			return fmt.Errorf(`错误的 get 选项 "%s"`, key)
+		}
+	}
+	if prop.CopyGetter {
+		if collKeyElem(prop.Type) == nil {
+			return errors.New("get 的 copy 选项仅适用于 slice 或 map 类型的属性")
+		}
+		if prop.IsRefGetter || prop.LazyLoader != "" {
+			return errors.New("get 的 copy 选项不可与引用 getter 或 lazy 选项同时使用")
+		}
+	}
+	if prop.LazyOnce != "" && prop.LazyLoader == "" {
+		return errors.New("get 的 once 选项需与 lazy 选项同时使用")
+	}
+	if prop.LazyLoader != "" {
+		if _, isAtomic := atomicValueType(prop.Type); isAtomic {
+			return errors.New("sync/atomic 类型不支持 get 的 lazy 选项")
+		}
+		prop.LazyOnce = cmp.Or(prop.LazyOnce, prop.Name+"Once")
+		if !isValidIdent(prop.LazyLoader) || !isValidIdent(prop.LazyOnce) {
+			return fmt.Errorf(`错误的 get 值 "%s"`, rawTagVal)
+		}
+	}
 	return nil
 }
 
 func (sc *scanner) parseSetTag(prop *Property, tagVal string) error {
-	//rawTagVal := tagVal
+	rawTagVal := tagVal
+	tagVal, options := splitTagOptions(tagVal)
+	if strings.HasPrefix(tagVal, "!") {
+		prop.IsChainSetter = true
+		tagVal = tagVal[1:]
+	}
 	switch tagVal {
 	case "", "@":
 		prop.Setter = "Set" + pascalCase(prop.Name)
 	default:
 		if !isValidIdent(tagVal) {
-			return fmt.Errorf(`错误的 get 值 "%s"`, tagVal)
+			return fmt.Errorf(`错误的 set 值 "%s"`, rawTagVal)
 		}
 		prop.Setter = tagVal
 	}
+
+	// 选项: validate=方法名 / after=方法名 / add=方法名 / cas=方法名(省略时使用默认方法名)，copy 保存副本
+	for key, value := range options {
+		switch key {
+		case "validate":
+			prop.SetValidator = cmp.Or(value, "validate"+pascalCase(prop.Name))
+		case "after":
+			prop.AfterSetHook = cmp.Or(value, "afterSet"+pascalCase(prop.Name))
+		case "add":
+			prop.AtomicAdder = cmp.Or(value, "Add"+pascalCase(prop.Name))
+		case "cas":
+			prop.AtomicCAS = cmp.Or(value, "CompareAndSwap"+pascalCase(prop.Name))
+		case "copy":
+			prop.CopySetter = true
+		default:
+			return fmt.Errorf(`错误的 set 选项 "%s"`, key)
+		}
+	}
+	if prop.SetValidator != "" && prop.IsChainSetter {
+		return errors.New("set 的 validate 选项不可与链式 setter 同时使用")
+	}
+	if prop.CopySetter && collKeyElem(prop.Type) == nil {
+		return errors.New("set 的 copy 选项仅适用于 slice 或 map 类型的属性")
+	}
+	// add / cas 选项仅适用于 sync/atomic 类型
+	if _, isAtomic := atomicValueType(prop.Type); !isAtomic && (prop.AtomicAdder != "" || prop.AtomicCAS != "") {
+		return errors.New("set 的 add / cas 选项仅适用于 sync/atomic 类型")
+	}
+	if prop.AtomicAdder != "" && !isAtomicIntType(prop.Type) {
+		return errors.New("set 的 add 选项仅适用于 sync/atomic 整数类型")
+	}
+	for _, hook := range []string{prop.SetValidator, prop.AfterSetHook, prop.AtomicAdder, prop.AtomicCAS} {
+		if hook != "" && !isValidIdent(hook) {
+			return fmt.Errorf(`错误的 set 值 "%s"`, rawTagVal)
+		}
+	}
+	return nil
+}
+
+// splitTagOptions 拆分 tag 值为首段的名称部分和其后逗号分隔的选项，如:
+//
+//	"SetX,validate=check,after" => "SetX", {"validate": "check", "after": ""}
+//
+// 首段包含 = 时视为选项，名称部分为空
+func splitTagOptions(tagVal string) (string, map[string]string) {
+	parts := strings.Split(tagVal, ",")
+	name, parts := parts[0], parts[1:]
+	if strings.Contains(name, "=") {
+		name, parts = "", append([]string{name}, parts...)
+	}
+
+	options := make(map[string]string, len(parts))
+	for _, part := range parts {
+		key, value, _ := strings.Cut(part, "=")
+		options[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return strings.TrimSpace(name), options
+}
+
+func (sc *scanner) parseWithTag(prop *Property, tagVal string) error {
+	switch tagVal {
+	case "":
+		prop.Wither = "With" + pascalCase(prop.Name)
+	default:
+		if !isValidIdent(tagVal) {
+			return fmt.Errorf(`错误的 with 值 "%s"`, tagVal)
+		}
+		prop.Wither = tagVal
+	}
+	return nil
+}
+
+func (sc *scanner) parseCollTag(prop *Property, tagVal string) error {
+	switch tagVal {
+	case "":
+		prop.Coll = pascalCase(prop.Name)
+	default:
+		if !isValidIdent(tagVal) {
+			return fmt.Errorf(`错误的 coll 值 "%s"`, tagVal)
+		}
+		prop.Coll = pascalCase(tagVal)
+	}
+	if collKeyElem(prop.Type) == nil {
+		return errors.New("coll 仅适用于 slice 或 map 类型的属性")
+	}
+	return nil
+}
+
+func (sc *scanner) parseIterTag(prop *Property, tagVal string) error {
+	switch tagVal {
+	case "":
+		prop.Iter = pascalCase(prop.Name) + "All"
+	default:
+		if !isValidIdent(tagVal) {
+			return fmt.Errorf(`错误的 iter 值 "%s"`, tagVal)
+		}
+		prop.Iter = tagVal
+	}
+	if collKeyElem(prop.Type) == nil {
+		return errors.New("iter 仅适用于 slice 或 map 类型的属性")
+	}
 	return nil
 }
 
 func (sc *scanner) parsePropTag(prop *Property, tagVal string) error {
 	rawTagVal := tagVal
-	if strings.HasPrefix(tagVal, "&") {
-		prop.IsRefGetter = true
+	for len(tagVal) > 0 && (tagVal[0] == '&' || tagVal[0] == '!') {
+		if tagVal[0] == '&' {
+			prop.IsRefGetter = true
+		} else {
+			prop.IsChainSetter = true
+		}
 		tagVal = tagVal[1:]
 	}
 
@@ -255,9 +980,30 @@ func (sc *scanner) parsePropTag(prop *Property, tagVal string) error {
 // 分析函数定义判断是否为某属性的 getter/setter
 
 func (sc *scanner) inspectFuncDecl(funcDecl *ast.FuncDecl) {
+	// 记录包级函数，用于避免生成重名函数
+	if funcDecl.Recv == nil {
+		sc.pkg.RecordExistingFunc(funcDecl.Name.Name)
+		return
+	}
+
 	// 获取并检查 recv
 	recvName, recvTypeName, ok := sc.getRecvOfFunc(funcDecl)
-	if !ok || recvName == "" || recvName == "_" || recvTypeName == "" {
+	if !ok || recvTypeName == "" {
+		return
+	}
+
+	// 记录已存在的方法名及接收者类型，用于避免生成重名方法及推断值接收者
+	recvTyp := sc.pkg.FindOrInitType(recvTypeName)
+	recvTyp.RecordExistingMethod(funcDecl.Name.Name)
+	_, isPtr := funcDecl.Recv.List[0].Type.(*ast.StarExpr)
+	recvTyp.RecordExistingRecvKind(isPtr)
+	if fnType := funcDecl.Type; funcDecl.Name.Name == "Clone" && fnType.Params.NumFields() == 0 && fnType.Results.NumFields() == 1 {
+		recvTyp.cloneResult = fnType.Results.List[0].Type
+	}
+	if fnType := funcDecl.Type; funcDecl.Name.Name == "Equal" && fnType.Params.NumFields() == 1 && fnType.Results.NumFields() == 1 {
+		recvTyp.equalParam = fnType.Params.List[0].Type
+	}
+	if recvName == "" || recvName == "_" {
 		return
 	}
 
@@ -265,12 +1011,26 @@ func (sc *scanner) inspectFuncDecl(funcDecl *ast.FuncDecl) {
 	sc.recordTypeRecvName(recvTypeName, recvName)
 
 	// 判断是否为事实上的 getter/setter
-	if funcDecl.Body == nil || len(funcDecl.Body.List) != 1 {
+	if funcDecl.Body == nil || len(funcDecl.Body.List) == 0 || len(funcDecl.Body.List) > 2 {
 		return
 	}
 	fnType := funcDecl.Type
 	stmt := funcDecl.Body.List[0]
-	if len(fnType.Params.List) == 0 && fnType.Results != nil && len(fnType.Results.List) == 1 { // check getter
+	numResults := fnType.Results.NumFields()
+	isChain := false
+	if len(funcDecl.Body.List) == 2 { // 链式 setter: t.x = v; return t
+		retStmt, ok := funcDecl.Body.List[1].(*ast.ReturnStmt)
+		if !ok || len(retStmt.Results) != 1 || numResults != 1 {
+			return
+		}
+		ret, ok := retStmt.Results[0].(*ast.Ident)
+		if !ok || ret.Name != recvName {
+			return
+		}
+		isChain = true
+	}
+
+	if !isChain && len(fnType.Params.List) == 0 && numResults == 1 { // check getter
 		retStmt, ok := stmt.(*ast.ReturnStmt)
 		if !ok || len(retStmt.Results) != 1 {
 			return
@@ -290,7 +1050,7 @@ func (sc *scanner) inspectFuncDecl(funcDecl *ast.FuncDecl) {
 		propName := sel.Sel.Name
 
 		sc.recordGetter(recvTypeName, propName, funcDecl.Name.Name)
-	} else if len(fnType.Params.List) == 1 && (fnType.Results == nil || len(fnType.Results.List) == 0) { // check setter
+	} else if len(fnType.Params.List) == 1 && (isChain || numResults == 0) { // check setter
 		// check param
 		if len(fnType.Params.List[0].Names) != 1 {
 			return
@@ -316,7 +1076,7 @@ func (sc *scanner) inspectFuncDecl(funcDecl *ast.FuncDecl) {
 		}
 		propName := left.Sel.Name
 
-		sc.recordSetter(recvTypeName, propName, funcDecl.Name.Name)
+		sc.recordSetter(recvTypeName, propName, funcDecl.Name.Name, isChain)
 	}
 }
 
@@ -326,18 +1086,26 @@ func (sc *scanner) getRecvOfFunc(funcDecl *ast.FuncDecl) (recvName string, recvT
 	}
 
 	field := funcDecl.Recv.List[0]
-	if len(field.Names) != 1 || field.Type == nil {
+	if len(field.Names) > 1 || field.Type == nil {
 		return
 	}
 
-	// 获取并检查 recv 名
-	recvName = field.Names[0].Name
+	// 获取 recv 名，匿名 recv 时为空
+	if len(field.Names) == 1 {
+		recvName = field.Names[0].Name
+	}
 
 	// 获取并检查 recv 类型名
 	recvType := field.Type
 	if t, ok := recvType.(*ast.StarExpr); ok {
 		recvType = t.X
 	}
+	switch t := recvType.(type) { // 泛型类型: T[K] 或 T[K, V]
+	case *ast.IndexExpr:
+		recvType = t.X
+	case *ast.IndexListExpr:
+		recvType = t.X
+	}
 	ident, ok := recvType.(*ast.Ident)
 	if !ok {
 		return
@@ -358,8 +1126,11 @@ func (sc *scanner) recordGetter(typName string, propName string, getter string)
 	prop.RecordExistingGetter(getter)
 }
 
-func (sc *scanner) recordSetter(typName string, propName string, setter string) {
+func (sc *scanner) recordSetter(typName string, propName string, setter string, isChain bool) {
 	typ := sc.pkg.FindOrInitType(typName)
 	prop := typ.FindOrInitProperty(propName)
 	prop.RecordExistingSetter(setter)
+	if isChain {
+		prop.RecordExistingChainSetter(setter)
+	}
 }
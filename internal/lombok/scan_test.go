@@ -2,6 +2,7 @@ package lombok
 
 import (
 	_ "embed"
+	"strings"
 	"testing"
 )
 
@@ -19,10 +20,11 @@ func assertEqual[T comparable](t *testing.T, name string, value T, expected T) b
 func TestScanCode(t *testing.T) {
 	var pkgName = "testdata"
 	type expectedProperty struct {
-		Name        string
-		Getter      string
-		IsRefGetter bool
-		Setter      string
+		Name          string
+		Getter        string
+		IsRefGetter   bool
+		Setter        string
+		IsChainSetter bool
 	}
 	tests := []struct {
 		name          string
@@ -50,6 +52,10 @@ func TestScanCode(t *testing.T) {
 				{Name: "p22", Getter: "name22", Setter: "", IsRefGetter: true},
 				{Name: "p23", Getter: "P23", Setter: "SetP23", IsRefGetter: true},
 				{Name: "p24", Getter: "Name24", Setter: "SetName24", IsRefGetter: true},
+				// chain set tag
+				{Name: "p31", Getter: "", Setter: "SetP31", IsChainSetter: true},
+				{Name: "p32", Getter: "GetP32", Setter: "SetP32", IsChainSetter: true},
+				{Name: "p33", Getter: "Name33", Setter: "SetName33", IsRefGetter: true, IsChainSetter: true},
 			},
 		},
 	}
@@ -78,6 +84,565 @@ func TestScanCode(t *testing.T) {
 				assertEqual(t, "props["+prop.Name+"].Getter", prop.Getter, expectedProp.Getter)
 				assertEqual(t, "props["+prop.Name+"].Setter", prop.Setter, expectedProp.Setter)
 				assertEqual(t, "props["+prop.Name+"].IsRefGetter", prop.IsRefGetter, expectedProp.IsRefGetter)
+				assertEqual(t, "props["+prop.Name+"].IsChainSetter", prop.IsChainSetter, expectedProp.IsChainSetter)
+			}
+		})
+	}
+}
+
+func TestTryGuessTag(t *testing.T) {
+	code := `package testdata
+
+type T struct {
+	host string
+	port int
+}
+
+func (t *T) Host() string { return t.host }
+func (t *T) SetHost(v string) *T {
+	t.host = v
+	return t
+}
+func (t *T) Port() int { return t.port }
+func (t *T) SetPort(v int) { t.port = v }
+`
+	pkg, err := ScanCode("testdata", code)
+	if err != nil {
+		t.Errorf("ScanCode(...) error = %v", err)
+		return
+	}
+
+	typ := pkg.FindType("T")
+	for propName, expected := range map[string]string{"host": "`prop:\"!\"`", "port": "`prop:\"\"`"} {
+		tag, _ := tryGuessTag(typ.FindProperty(propName))
+		assertEqual(t, "tryGuessTag("+propName+")", tag, expected)
+	}
+}
+
+func TestScanCodeDelegateModuleType(t *testing.T) {
+	code := `package testdata
+
+import "github.com/heyuuu/go-lombok/internal/utils/astkit"
+
+type T struct {
+	imports *astkit.Imports ` + "`delegate:\"\"`" + `
+}
+`
+	pkg, err := ScanCode("testdata", code)
+	if err != nil {
+		t.Fatalf("ScanCode(...) error = %v", err)
+	}
+	var names []string
+	for _, method := range pkg.FindType("T").FindProperty("imports").delegateMethods {
+		names = append(names, method.name)
+	}
+	assertEqual(t, "delegate methods", strings.Join(names, ","), "Build,FindOrAdd")
+}
+
+func TestScanCodeInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		wantErr string // 期望的错误信息片段
+	}{
+		{
+			name: "accessor conflict",
+			code: `package testdata
+
+type Base struct{}
+
+type Entity struct {
+	Base ` + "`get:\"\"`" + `
+}
+`,
+			wantErr: "生成的方法 Base 与字段重名",
+		},
+		{
+			name: "builder method conflicts with build",
+			code: `package testdata
+
+type T struct {
+	build int ` + "`get:\"\" builder:\"\"`" + `
+}
+`,
+			wantErr: "build 属性的 Builder 方法 Build 与 Build 方法冲突",
+		},
+		{
+			name: "builder type exists",
+			code: `package testdata
+
+type T struct {
+	a int ` + "`get:\"\" builder:\"\"`" + `
+}
+
+type TBuilder struct{}
+`,
+			wantErr: "Builder 类型 TBuilder 已存在",
+		},
+		{
+			name: "clone with sync.Once field",
+			code: `package testdata
+
+import "sync"
+
+type T struct {
+	a    int ` + "`clone:\"\"`" + `
+	once sync.Once
+}
+`,
+			wantErr: "once 字段为 sync.Once 类型，无法复制其状态",
+		},
+		{
+			name: "clone with nested lock",
+			code: `package testdata
+
+import "sync"
+
+type T struct {
+	a     int ` + "`clone:\"\"`" + `
+	stats Stats
+}
+
+type Stats struct {
+	mu    sync.Mutex
+	count int
+}
+`,
+			wantErr: "stats 字段的类型包含 sync 等不可复制的字段，不可生成 Clone 方法",
+		},
+		{
+			name: "wither with nested lock",
+			code: `package testdata
+
+import "sync"
+
+type T struct {
+	a     int ` + "`with:\"\"`" + `
+	stats Stats
+}
+
+type Stats struct {
+	mu sync.Mutex
+}
+`,
+			wantErr: "类型 T 的 a 属性不可生成 wither: 类型包含不可复制的字段 stats",
+		},
+		{
+			name: "delegate import failure",
+			code: `package testdata
+
+import "example.com/missing/pkg"
+
+type T struct {
+	a pkg.Reader ` + "`delegate:\"\"`" + `
+}
+`,
+			wantErr: "could not import example.com/missing/pkg",
+		},
+		{
+			name: "value recv with equal",
+			code: `package testdata
+
+type T struct {
+	a int ` + "`get:\"\" equal:\"\" recv:\",value\"`" + `
+}
+`,
+			wantErr: "类型 T 使用值接收者，不可生成 Equal 方法",
+		},
+		{
+			name: "value recv with track",
+			code: `package testdata
+
+//lombok:track
+type T struct {
+	a     int ` + "`get:\"\" recv:\",value\"`" + `
+	dirty uint8
+}
+`,
+			wantErr: "类型 T 使用值接收者，不可使用 track 记录脏字段",
+		},
+		{
+			name: "value recv with options",
+			code: `package testdata
+
+type T struct {
+	a int ` + "`get:\"\" options:\"\" recv:\",value\"`" + `
+}
+`,
+			wantErr: "类型 T 使用值接收者，不可生成函数式选项",
+		},
+		{
+			name: "value recv with nested lock",
+			code: `package testdata
+
+import "sync"
+
+type T struct {
+	a     int ` + "`get:\"\" recv:\",value\"`" + `
+	stats Stats
+}
+
+type Stats struct {
+	mu sync.Mutex
+}
+`,
+			wantErr: "类型 T 使用值接收者，不可包含不可复制的字段 stats",
+		},
+		{
+			name: "missing set hook",
+			code: `package testdata
+
+type T struct {
+	port int ` + "`set:\",validate\"`" + `
+}
+`,
+			wantErr: "引用的方法 validatePort 不存在",
+		},
+		{
+			name: "missing lazy once field",
+			code: `package testdata
+
+type T struct {
+	port int ` + "`get:\",lazy\"`" + `
+}
+
+func (t *T) loadPort() int { return 80 }
+`,
+			wantErr: "sync.Once 字段 portOnce 不存在",
+		},
+		{
+			name: "lazy with setter",
+			code: `package testdata
+
+import "sync"
+
+type T struct {
+	port     int ` + "`get:\",lazy\" set:\"\"`" + `
+	portOnce sync.Once
+}
+
+func (t *T) loadPort() int { return 80 }
+`,
+			wantErr: "port 属性为延迟加载，不可生成 setter",
+		},
+		{
+			name: "lazy with guard",
+			code: `package testdata
+
+import "sync"
+
+type T struct {
+	mu       sync.Mutex
+	port     int ` + "`get:\",lazy\" guard:\"mu\"`" + `
+	portOnce sync.Once
+}
+
+func (t *T) loadPort() int { return 80 }
+`,
+			wantErr: "port 属性为延迟加载，不可与 guard 同时使用",
+		},
+		{
+			name: "missing guard field",
+			code: `package testdata
+
+type T struct {
+	port int ` + "`get:\"\" guard:\"mu\"`" + `
+}
+`,
+			wantErr: "锁字段 mu 不存在",
+		},
+		{
+			name: "invalid guard field type",
+			code: `package testdata
+
+type T struct {
+	port int ` + "`get:\"\" guard:\"mu\"`" + `
+	mu   int
+}
+`,
+			wantErr: "锁字段 mu 必须为 sync.Mutex 或 sync.RWMutex 类型",
+		},
+		{
+			name: "add on non-atomic field",
+			code: `package testdata
+
+type T struct {
+	hits int64 ` + "`set:\",add\"`" + `
+}
+`,
+			wantErr: "add / cas 选项仅适用于 sync/atomic 类型",
+		},
+		{
+			name: "add on atomic bool",
+			code: `package testdata
+
+import "sync/atomic"
+
+type T struct {
+	ready atomic.Bool ` + "`set:\",add\"`" + `
+}
+`,
+			wantErr: "add 选项仅适用于 sync/atomic 整数类型",
+		},
+		{
+			name: "track atomic field without guard",
+			code: `package testdata
+
+import "sync/atomic"
+
+//lombok:track
+type T struct {
+	dirty uint8
+	hits  atomic.Int64 ` + "`set:\"\"`" + `
+}
+`,
+			wantErr: "hits 属性为 sync/atomic 类型，标记脏字段掩码不是原子操作",
+		},
+		{
+			name: "coll on non-collection field",
+			code: `package testdata
+
+type T struct {
+	name string ` + "`coll:\"\"`" + `
+}
+`,
+			wantErr: "coll 仅适用于 slice 或 map 类型的属性",
+		},
+		{
+			name: "copy on non-collection field",
+			code: `package testdata
+
+type T struct {
+	name string ` + "`get:\",copy\"`" + `
+}
+`,
+			wantErr: "get 的 copy 选项仅适用于 slice 或 map 类型的属性",
+		},
+		{
+			name: "copy with ref getter",
+			code: `package testdata
+
+type T struct {
+	names []string ` + "`get:\"&,copy\"`" + `
+}
+`,
+			wantErr: "get 的 copy 选项不可与引用 getter 或 lazy 选项同时使用",
+		},
+		{
+			name: "default not a number",
+			code: `package testdata
+
+type T struct {
+	port int ` + "`get:\"\" default:\"abc\"`" + `
+}
+`,
+			wantErr: "不是合法的 int 值",
+		},
+		{
+			name: "default overflow",
+			code: `package testdata
+
+type T struct {
+	port uint8 ` + "`get:\"\" default:\"300\"`" + `
+}
+`,
+			wantErr: "不是合法的 uint8 值",
+		},
+		{
+			name: "default zero value",
+			code: `package testdata
+
+type T struct {
+	port int ` + "`get:\"\" default:\"0\"`" + `
+}
+`,
+			wantErr: "default 值不可为零值",
+		},
+		{
+			name: "default invalid duration",
+			code: `package testdata
+
+import "time"
+
+type T struct {
+	timeout time.Duration ` + "`get:\"\" default:\"5\"`" + `
+}
+`,
+			wantErr: "不是合法的 time.Duration 值",
+		},
+		{
+			name: "default unsupported type",
+			code: `package testdata
+
+type T struct {
+	names []string ` + "`get:\"\" default:\"a\"`" + `
+}
+`,
+			wantErr: "default 仅适用于整数、浮点数、字符串、布尔及 time.Duration 类型的属性",
+		},
+		{
+			name: "default false on bool",
+			code: `package testdata
+
+type T struct {
+	on bool ` + "`get:\"\" default:\"false\"`" + `
+}
+`,
+			wantErr: "default 值不可为零值",
+		},
+		{
+			name: "default without getter",
+			code: `package testdata
+
+type T struct {
+	port int ` + "`set:\"\" default:\"80\"`" + `
+}
+`,
+			wantErr: "default 需与非引用、非延迟加载的 getter 同时使用",
+		},
+		{
+			name: "conflicting methods",
+			code: `package testdata
+
+type Reader interface{ Read() string }
+
+type T struct {
+	a Reader ` + "`delegate:\"\"`" + `
+	b Reader ` + "`delegate:\"\"`" + `
+}
+`,
+			wantErr: "delegate 方法 Read 冲突",
+		},
+		{
+			name: "unresolved type",
+			code: `package testdata
+
+type T struct {
+	a Missing ` + "`delegate:\"\"`" + `
+}
+`,
+			wantErr: "a 属性类型无法解析",
+		},
+		{
+			name: "non-integer type",
+			code: `package testdata
+
+//lombok:enum
+type S string
+
+const (
+	SA S = "a"
+	SB S = "b"
+)
+`,
+			wantErr: "底层类型必须为整数类型",
+		},
+		{
+			name: "no constants",
+			code: `package testdata
+
+//lombok:enum
+type E int
+`,
+			wantErr: "枚举类型 E 未定义常量",
+		},
+		{
+			name: "struct type",
+			code: `package testdata
+
+//lombok:enum
+type T struct {
+	a int
+}
+`,
+			wantErr: "\"//lombok:enum\" 仅适用于整数类型",
+		},
+		{
+			name: "invalid prefix",
+			code: `package testdata
+
+type T struct {
+	a int ` + "`get:\"\" options:\"a-b\"`" + `
+}
+`,
+			wantErr: "错误的 options 值 \"a-b\"",
+		},
+		{
+			name: "conflicting funcs",
+			code: `package testdata
+
+//lombok:options
+type A struct {
+	name string ` + "`get:\"\"`" + `
+}
+
+//lombok:options
+type B struct {
+	name string ` + "`get:\"\"`" + `
+}
+`,
+			wantErr: "函数式选项 WithName 冲突",
+		},
+		{
+			name: "setter",
+			code: `package testdata
+
+type T struct {
+	a int ` + "`get:\"\" set:\"\" recv:\",value\"`" + `
+}
+`,
+			wantErr: "不可生成 a 属性的 setter",
+		},
+		{
+			name: "nilsafe",
+			code: `package testdata
+
+//lombok:nilsafe
+type T struct {
+	a int ` + "`get:\"\" recv:\",value\"`" + `
+}
+`,
+			wantErr: "不可生成 nilsafe getter",
+		},
+		{
+			name: "no-copy field",
+			code: `package testdata
+
+import "sync"
+
+type T struct {
+	mu sync.Mutex
+	a  int ` + "`get:\"\" recv:\",value\"`" + `
+}
+`,
+			wantErr: "不可包含不可复制的字段 mu",
+		},
+		{
+			name: "unknown option",
+			code: `package testdata
+
+type T struct {
+	a int ` + "`get:\"\" recv:\"t,ref\"`" + `
+}
+`,
+			wantErr: "错误的 recv 选项 \"ref\"",
+		},
+		{
+			name: "value and pointer",
+			code: `package testdata
+
+type T struct {
+	a int ` + "`get:\"\" recv:\"t,value,pointer\"`" + `
+}
+`,
+			wantErr: "value 与 pointer 不可同时使用",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ScanCode("testdata", test.code)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("ScanCode(...) error = %v, want error containing %q", err, test.wantErr)
 			}
 		})
 	}
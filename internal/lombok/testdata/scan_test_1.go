@@ -15,4 +15,8 @@ type ScanTest struct {
 	p22 string `get:"&name22"`
 	p23 string `prop:"&"`
 	p24 string `prop:"&name24"`
+	// chain set tag
+	p31 string `set:"!"`
+	p32 string `prop:"!@"`
+	p33 string `prop:"&!name33"`
 }
@@ -4,4 +4,5 @@ type T struct {
 	p1 string `get:""`
 	p2 string `get:"@"`
 	p3 string `prop:"@"`
+	p4 int    `set:"!"`
 }
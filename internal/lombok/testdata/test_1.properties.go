@@ -13,3 +13,7 @@ func (t *T) GetP3() string {
 func (t *T) SetP3(v string) {
 	t.p3 = v
 }
+func (t *T) SetP4(v int) *T {
+	t.p4 = v
+	return t
+}
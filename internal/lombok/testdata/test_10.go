@@ -0,0 +1,39 @@
+package testdata
+
+import "sync"
+
+// User is a domain model
+//
+//lombok:data
+type User struct {
+	id       int64
+	name     string `get:"@"`
+	email    string //lombok:ignore
+	Exported string
+	mu       sync.Mutex
+}
+
+//lombok:value
+//lombok:builder
+type Money struct {
+	amount   int64
+	currency string
+}
+
+// Account 已有手写的 Owner / SetBalance 方法，指令不再重复生成
+//
+//lombok:data
+type Account struct {
+	owner   string
+	balance int64
+}
+
+func (a *Account) Owner() string {
+	return "@" + a.owner
+}
+
+func (a *Account) SetBalance(v int64) {
+	if v >= 0 {
+		a.balance = v
+	}
+}
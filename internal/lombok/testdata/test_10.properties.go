@@ -0,0 +1,164 @@
+package testdata
+
+import (
+	"fmt"
+	"hash/maphash"
+)
+
+// properties for Account
+func (a *Account) SetOwner(v string) {
+	a.owner = v
+}
+func (a *Account) Balance() int64 {
+	return a.balance
+}
+
+// string methods for Account
+func (a *Account) String() string {
+	return fmt.Sprintf("Account{owner=%v, balance=%v}", a.owner, a.balance)
+}
+func (a *Account) GoString() string {
+	return fmt.Sprintf("Account{owner:%#v, balance:%#v}", a.owner, a.balance)
+}
+
+// equal methods for Account
+func (a *Account) Equal(other *Account) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.owner != other.owner {
+		return false
+	}
+	if a.balance != other.balance {
+		return false
+	}
+	return true
+}
+func (a *Account) Hash() uint64 {
+	if a == nil {
+		return 0
+	}
+	var h maphash.Hash
+	h.SetSeed(lombokHashSeed)
+	maphash.WriteComparable(&h, a.owner)
+	maphash.WriteComparable(&h, a.balance)
+	return h.Sum64()
+}
+
+// properties for Money
+func (t *Money) Amount() int64 {
+	return t.amount
+}
+func (t *Money) Currency() string {
+	return t.currency
+}
+
+// constructors for Money
+func NewMoney(amount int64, currency string) *Money {
+	return &Money{amount: amount, currency: currency}
+}
+
+// string methods for Money
+func (t *Money) String() string {
+	return fmt.Sprintf("Money{amount=%v, currency=%v}", t.amount, t.currency)
+}
+func (t *Money) GoString() string {
+	return fmt.Sprintf("Money{amount:%#v, currency:%#v}", t.amount, t.currency)
+}
+
+// equal methods for Money
+func (t *Money) Equal(other *Money) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	if t.amount != other.amount {
+		return false
+	}
+	if t.currency != other.currency {
+		return false
+	}
+	return true
+}
+func (t *Money) Hash() uint64 {
+	if t == nil {
+		return 0
+	}
+	var h maphash.Hash
+	h.SetSeed(lombokHashSeed)
+	maphash.WriteComparable(&h, t.amount)
+	maphash.WriteComparable(&h, t.currency)
+	return h.Sum64()
+}
+
+// builder for Money
+type MoneyBuilder struct {
+	target Money
+}
+
+func NewMoneyBuilder() *MoneyBuilder {
+	return &MoneyBuilder{}
+}
+func (b *MoneyBuilder) Amount(v int64) *MoneyBuilder {
+	b.target.amount = v
+	return b
+}
+func (b *MoneyBuilder) Currency(v string) *MoneyBuilder {
+	b.target.currency = v
+	return b
+}
+func (b *MoneyBuilder) Build() (*Money, error) {
+	return &Money{amount: b.target.amount, currency: b.target.currency}, nil
+}
+
+// properties for User
+func (t *User) Id() int64 {
+	return t.id
+}
+func (t *User) SetId(v int64) {
+	t.id = v
+}
+func (t *User) GetName() string {
+	return t.name
+}
+func (t *User) SetName(v string) {
+	t.name = v
+}
+
+// string methods for User
+func (t *User) String() string {
+	return fmt.Sprintf("User{id=%v, name=%v, Exported=%v}", t.id, t.name, t.Exported)
+}
+func (t *User) GoString() string {
+	return fmt.Sprintf("User{id:%#v, name:%#v, Exported:%#v}", t.id, t.name, t.Exported)
+}
+
+// equal methods for User
+func (t *User) Equal(other *User) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	if t.id != other.id {
+		return false
+	}
+	if t.name != other.name {
+		return false
+	}
+	if t.Exported != other.Exported {
+		return false
+	}
+	return true
+}
+func (t *User) Hash() uint64 {
+	if t == nil {
+		return 0
+	}
+	var h maphash.Hash
+	h.SetSeed(lombokHashSeed)
+	maphash.WriteComparable(&h, t.id)
+	maphash.WriteComparable(&h, t.name)
+	maphash.WriteComparable(&h, t.Exported)
+	return h.Sum64()
+}
+
+// hash seed for generated Hash methods
+var lombokHashSeed = maphash.MakeSeed()
@@ -0,0 +1,27 @@
+package testdata
+
+import "errors"
+
+type Server struct {
+	port    int    `get:"" set:",validate"`
+	host    string `get:"" set:"SetHost,validate=checkHost,after=onHostChanged"`
+	retries int    `set:",after"`
+}
+
+func (s *Server) validatePort(v int) error {
+	if v <= 0 || v > 65535 {
+		return errors.New("invalid port")
+	}
+	return nil
+}
+
+func (s *Server) checkHost(v string) error {
+	if v == "" {
+		return errors.New("empty host")
+	}
+	return nil
+}
+
+func (s *Server) onHostChanged(old, new string) {}
+
+func (s *Server) afterSetRetries(old, new int) {}
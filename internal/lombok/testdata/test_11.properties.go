@@ -0,0 +1,30 @@
+package testdata
+
+// properties for Server
+func (s *Server) Port() int {
+	return s.port
+}
+func (s *Server) SetPort(v int) error {
+	if err := s.validatePort(v); err != nil {
+		return err
+	}
+	s.port = v
+	return nil
+}
+func (s *Server) Host() string {
+	return s.host
+}
+func (s *Server) SetHost(v string) error {
+	if err := s.checkHost(v); err != nil {
+		return err
+	}
+	old := s.host
+	s.host = v
+	s.onHostChanged(old, v)
+	return nil
+}
+func (s *Server) SetRetries(v int) {
+	old := s.retries
+	s.retries = v
+	s.afterSetRetries(old, v)
+}
@@ -0,0 +1,10 @@
+package testdata
+
+//lombok:setter
+//lombok:track
+type User struct {
+	id    int64 `get:""`
+	name  string
+	email string
+	dirty uint8
+}
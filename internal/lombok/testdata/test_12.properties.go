@@ -0,0 +1,46 @@
+package testdata
+
+import "slices"
+
+// properties for User
+func (t *User) Id() int64 {
+	return t.id
+}
+func (t *User) SetId(v int64) {
+	t.id = v
+	t.dirty |= 1 << 0
+}
+func (t *User) SetName(v string) {
+	t.name = v
+	t.dirty |= 1 << 1
+}
+func (t *User) SetEmail(v string) {
+	t.email = v
+	t.dirty |= 1 << 2
+}
+
+// dirty tracking for User
+const (
+	UserFieldId    = "id"
+	UserFieldName  = "name"
+	UserFieldEmail = "email"
+)
+
+var lombokUserDirtyFields = [...]string{UserFieldId, UserFieldName, UserFieldEmail}
+
+func (t *User) DirtyFields() []string {
+	var fields []string
+	for i, name := range lombokUserDirtyFields {
+		if t.dirty&(1<<i) != 0 {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+func (t *User) IsDirty(name string) bool {
+	i := slices.Index(lombokUserDirtyFields[:], name)
+	return i >= 0 && t.dirty&(1<<i) != 0
+}
+func (t *User) ResetDirty() {
+	t.dirty = 0
+}
@@ -0,0 +1,19 @@
+package testdata
+
+import "sync"
+
+type Report struct {
+	rows        []string
+	summary     string         `get:"lazy=buildSummary"`
+	stats       map[string]int `get:"&Stats,lazy,once=statsLoaded"`
+	summaryOnce sync.Once
+	statsLoaded sync.Once
+}
+
+func (r *Report) buildSummary() string {
+	return "rows"
+}
+
+func (r *Report) loadStats() map[string]int {
+	return map[string]int{"rows": len(r.rows)}
+}
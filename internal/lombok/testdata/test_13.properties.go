@@ -0,0 +1,15 @@
+package testdata
+
+// properties for Report
+func (r *Report) Summary() string {
+	r.summaryOnce.Do(func() {
+		r.summary = r.buildSummary()
+	})
+	return r.summary
+}
+func (r *Report) Stats() *map[string]int {
+	r.statsLoaded.Do(func() {
+		r.stats = r.loadStats()
+	})
+	return &r.stats
+}
@@ -0,0 +1,45 @@
+package testdata
+
+import "sync"
+
+//lombok:getter
+//lombok:setter
+//lombok:guard mu
+type Registry struct {
+	mu      sync.RWMutex
+	names   []string
+	version int `set:"!"`
+}
+
+type Counter struct {
+	count int `prop:"" guard:"lock"`
+	lock  *sync.Mutex
+}
+
+// Listener 的后置 hook 在解锁后调用，hook 中可以调用加锁的 getter
+//
+//lombok:guard mu
+type Listener struct {
+	mu    sync.Mutex
+	state string `get:"" set:",validate,after"`
+	count int    `get:"" set:"!,after=onCount"`
+}
+
+func (l *Listener) validateState(v string) error {
+	return nil
+}
+
+func (l *Listener) afterSetState(old, new string) {
+	_ = l.State()
+}
+
+func (l *Listener) onCount(old, new int) {}
+
+// Snapshot 的 String / Equal / Hash / Clone 同样在锁内读取属性
+//
+//lombok:guard mu
+type Snapshot struct {
+	mu    sync.RWMutex
+	name  string   `get:"" set:"" tostring:"" equal:"hash" clone:""`
+	items []string `get:""`
+}
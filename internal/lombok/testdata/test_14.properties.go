@@ -0,0 +1,149 @@
+package testdata
+
+import (
+	"fmt"
+	"hash/maphash"
+	"slices"
+)
+
+// properties for Counter
+func (t *Counter) Count() int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.count
+}
+func (t *Counter) SetCount(v int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.count = v
+}
+
+// properties for Listener
+func (l *Listener) State() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.state
+}
+func (l *Listener) SetState(v string) error {
+	l.mu.Lock()
+	if err := l.validateState(v); err != nil {
+		l.mu.Unlock()
+		return err
+	}
+	old := l.state
+	l.state = v
+	l.mu.Unlock()
+	l.afterSetState(old, v)
+	return nil
+}
+func (l *Listener) Count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.count
+}
+func (l *Listener) SetCount(v int) *Listener {
+	l.mu.Lock()
+	old := l.count
+	l.count = v
+	l.mu.Unlock()
+	l.onCount(old, v)
+	return l
+}
+
+// properties for Registry
+func (t *Registry) Names() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.names
+}
+func (t *Registry) SetNames(v []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.names = v
+}
+func (t *Registry) Version() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.version
+}
+func (t *Registry) SetVersion(v int) *Registry {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.version = v
+	return t
+}
+
+// properties for Snapshot
+func (t *Snapshot) Name() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.name
+}
+func (t *Snapshot) SetName(v string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.name = v
+}
+func (t *Snapshot) Items() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.items
+}
+
+// string methods for Snapshot
+func (t *Snapshot) String() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return fmt.Sprintf("Snapshot{name=%v, items=%v}", t.name, t.items)
+}
+func (t *Snapshot) GoString() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return fmt.Sprintf("Snapshot{name:%#v, items:%#v}", t.name, t.items)
+}
+
+// equal methods for Snapshot
+func (t *Snapshot) Equal(other *Snapshot) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.name != other.name {
+		return false
+	}
+	if !slices.Equal(t.items, other.items) {
+		return false
+	}
+	return true
+}
+func (t *Snapshot) Hash() uint64 {
+	if t == nil {
+		return 0
+	}
+	var h maphash.Hash
+	h.SetSeed(lombokHashSeed)
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	maphash.WriteComparable(&h, t.name)
+	for _, e := range t.items {
+		maphash.WriteComparable(&h, e)
+	}
+	return h.Sum64()
+}
+
+// clone method for Snapshot
+func (t *Snapshot) Clone() *Snapshot {
+	if t == nil {
+		return nil
+	}
+	c := &Snapshot{}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	c.name = t.name
+	c.items = slices.Clone(t.items)
+	return c
+}
+
+// hash seed for generated Hash methods
+var lombokHashSeed = maphash.MakeSeed()
@@ -0,0 +1,31 @@
+package testdata
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type Node struct {
+	name string
+}
+
+type Stats struct {
+	hits    atomic.Int64         `get:"" set:",add,cas"`
+	ready   atomic.Bool          `prop:""`
+	version atomic.Uint32        `get:"@" set:",after"`
+	head    atomic.Pointer[Node] `get:"" set:",cas"`
+	config  atomic.Value         `get:""`
+	total   atomic.Int64         `get:"&"`
+}
+
+func (s *Stats) afterSetVersion(old, new uint32) {}
+
+//lombok:getter
+//lombok:track
+//lombok:guard mu
+type Gauge struct {
+	mu    sync.Mutex
+	dirty uint8
+	value atomic.Int64 `set:",add=Incr"`
+	label string
+}
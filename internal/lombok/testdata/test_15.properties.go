@@ -0,0 +1,102 @@
+package testdata
+
+import (
+	"slices"
+	"sync/atomic"
+)
+
+// properties for Gauge
+func (t *Gauge) Value() int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.value.Load()
+}
+func (t *Gauge) SetValue(v int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.value.Store(v)
+	t.dirty |= 1 << 0
+}
+func (t *Gauge) Incr(delta int64) int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.dirty |= 1 << 0
+	return t.value.Add(delta)
+}
+func (t *Gauge) Label() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.label
+}
+
+// dirty tracking for Gauge
+const (
+	GaugeFieldValue = "value"
+)
+
+var lombokGaugeDirtyFields = [...]string{GaugeFieldValue}
+
+func (t *Gauge) DirtyFields() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var fields []string
+	for i, name := range lombokGaugeDirtyFields {
+		if t.dirty&(1<<i) != 0 {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+func (t *Gauge) IsDirty(name string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	i := slices.Index(lombokGaugeDirtyFields[:], name)
+	return i >= 0 && t.dirty&(1<<i) != 0
+}
+func (t *Gauge) ResetDirty() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.dirty = 0
+}
+
+// properties for Stats
+func (s *Stats) Hits() int64 {
+	return s.hits.Load()
+}
+func (s *Stats) SetHits(v int64) {
+	s.hits.Store(v)
+}
+func (s *Stats) AddHits(delta int64) int64 {
+	return s.hits.Add(delta)
+}
+func (s *Stats) CompareAndSwapHits(old, new int64) bool {
+	return s.hits.CompareAndSwap(old, new)
+}
+func (s *Stats) Ready() bool {
+	return s.ready.Load()
+}
+func (s *Stats) SetReady(v bool) {
+	s.ready.Store(v)
+}
+func (s *Stats) GetVersion() uint32 {
+	return s.version.Load()
+}
+func (s *Stats) SetVersion(v uint32) {
+	old := s.version.Swap(v)
+	s.afterSetVersion(old, v)
+}
+func (s *Stats) Head() *Node {
+	return s.head.Load()
+}
+func (s *Stats) SetHead(v *Node) {
+	s.head.Store(v)
+}
+func (s *Stats) CompareAndSwapHead(old, new *Node) bool {
+	return s.head.CompareAndSwap(old, new)
+}
+func (s *Stats) Config() any {
+	return s.config.Load()
+}
+func (s *Stats) Total() *atomic.Int64 {
+	return &s.total
+}
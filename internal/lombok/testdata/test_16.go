@@ -0,0 +1,22 @@
+package testdata
+
+import (
+	"sync"
+	"time"
+)
+
+type Playlist struct {
+	songs   []string       `coll:""`
+	ratings map[string]int `get:"" coll:"Rating"`
+	history []time.Time    `coll:"Played"`
+}
+
+func (p *Playlist) SongsLen() int { return len(p.songs) }
+
+//lombok:guard mu
+//lombok:track
+type Inventory struct {
+	mu    sync.RWMutex
+	dirty uint8
+	stock map[string]int `coll:""`
+}
@@ -0,0 +1,106 @@
+package testdata
+
+import (
+	"slices"
+	"time"
+)
+
+// properties for Inventory
+func (t *Inventory) StockGet(k string) (int, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	v, ok := t.stock[k]
+	return v, ok
+}
+func (t *Inventory) PutStock(k string, v int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stock == nil {
+		t.stock = make(map[string]int)
+	}
+	t.stock[k] = v
+	t.dirty |= 1 << 0
+}
+func (t *Inventory) DeleteStock(k string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.stock, k)
+	t.dirty |= 1 << 0
+}
+func (t *Inventory) StockLen() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.stock)
+}
+
+// dirty tracking for Inventory
+const (
+	InventoryFieldStock = "stock"
+)
+
+var lombokInventoryDirtyFields = [...]string{InventoryFieldStock}
+
+func (t *Inventory) DirtyFields() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var fields []string
+	for i, name := range lombokInventoryDirtyFields {
+		if t.dirty&(1<<i) != 0 {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+func (t *Inventory) IsDirty(name string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	i := slices.Index(lombokInventoryDirtyFields[:], name)
+	return i >= 0 && t.dirty&(1<<i) != 0
+}
+func (t *Inventory) ResetDirty() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.dirty = 0
+}
+
+// properties for Playlist
+func (p *Playlist) AddSongs(v ...string) {
+	p.songs = append(p.songs, v...)
+}
+func (p *Playlist) RemoveSongsAt(i int) {
+	p.songs = slices.Delete(p.songs, i, i+1)
+}
+func (p *Playlist) SongsAt(i int) string {
+	return p.songs[i]
+}
+func (p *Playlist) Ratings() map[string]int {
+	return p.ratings
+}
+func (p *Playlist) RatingGet(k string) (int, bool) {
+	v, ok := p.ratings[k]
+	return v, ok
+}
+func (p *Playlist) PutRating(k string, v int) {
+	if p.ratings == nil {
+		p.ratings = make(map[string]int)
+	}
+	p.ratings[k] = v
+}
+func (p *Playlist) DeleteRating(k string) {
+	delete(p.ratings, k)
+}
+func (p *Playlist) RatingLen() int {
+	return len(p.ratings)
+}
+func (p *Playlist) AddPlayed(v ...time.Time) {
+	p.history = append(p.history, v...)
+}
+func (p *Playlist) RemovePlayedAt(i int) {
+	p.history = slices.Delete(p.history, i, i+1)
+}
+func (p *Playlist) PlayedLen() int {
+	return len(p.history)
+}
+func (p *Playlist) PlayedAt(i int) time.Time {
+	return p.history[i]
+}
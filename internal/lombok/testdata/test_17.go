@@ -0,0 +1,19 @@
+package testdata
+
+import "sync"
+
+type Key struct {
+	id int
+}
+
+type Team struct {
+	members []string    `get:"" iter:""`
+	scores  map[Key]int `iter:"Scores"`
+	tags    []*Key      `iter:""`
+}
+
+//lombok:guard mu
+type Roster struct {
+	mu    sync.Mutex
+	names []string `iter:""`
+}
@@ -0,0 +1,28 @@
+package testdata
+
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+// properties for Roster
+func (t *Roster) NamesAll() iter.Seq[string] {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.Values(slices.Clone(t.names))
+}
+
+// properties for Team
+func (t *Team) Members() []string {
+	return t.members
+}
+func (t *Team) MembersAll() iter.Seq[string] {
+	return slices.Values(t.members)
+}
+func (t *Team) Scores() iter.Seq2[Key, int] {
+	return maps.All(t.scores)
+}
+func (t *Team) TagsAll() iter.Seq[*Key] {
+	return slices.Values(t.tags)
+}
@@ -0,0 +1,17 @@
+package testdata
+
+import "fmt"
+
+type Role struct {
+	permissions []string          `get:",copy" set:",copy"`
+	grants      map[string]bool   `get:"@,copy" set:"!,copy"`
+	owners      []string          `set:",copy,validate"`
+	labels      map[string]string `get:"" set:",copy"`
+}
+
+func (r *Role) validateOwners(v []string) error {
+	if len(v) == 0 {
+		return fmt.Errorf("empty owners")
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package testdata
+
+import (
+	"maps"
+	"slices"
+)
+
+// properties for Role
+func (r *Role) Permissions() []string {
+	return slices.Clone(r.permissions)
+}
+func (r *Role) SetPermissions(v []string) {
+	r.permissions = slices.Clone(v)
+}
+func (r *Role) GetGrants() map[string]bool {
+	return maps.Clone(r.grants)
+}
+func (r *Role) SetGrants(v map[string]bool) *Role {
+	r.grants = maps.Clone(v)
+	return r
+}
+func (r *Role) SetOwners(v []string) error {
+	if err := r.validateOwners(v); err != nil {
+		return err
+	}
+	r.owners = slices.Clone(v)
+	return nil
+}
+func (r *Role) Labels() map[string]string {
+	return r.labels
+}
+func (r *Role) SetLabels(v map[string]string) {
+	r.labels = maps.Clone(v)
+}
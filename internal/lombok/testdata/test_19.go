@@ -0,0 +1,33 @@
+package testdata
+
+import (
+	"sync"
+	"time"
+)
+
+//lombok:getter
+//lombok:nilsafe
+type Node struct {
+	name     string
+	parent   *Node
+	children []*Node
+	meta     map[string]any
+	timeout  time.Duration
+	created  time.Time
+	cb       func()
+}
+
+type Tree struct {
+	root  *Node  `get:"" nilsafe:""`
+	size  int    `get:"&"`
+	label string `get:",lazy"`
+
+	labelOnce sync.Once
+}
+
+type Forest struct {
+	mu   sync.RWMutex
+	tags []string `get:"" guard:"mu" nilsafe:""`
+}
+
+func (t *Tree) loadLabel() string { return "tree" }
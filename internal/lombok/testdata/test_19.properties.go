@@ -0,0 +1,84 @@
+package testdata
+
+import "time"
+
+// properties for Forest
+func (t *Forest) Tags() []string {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.tags
+}
+
+// properties for Node
+func (t *Node) Name() string {
+	if t == nil {
+		var zero string
+		return zero
+	}
+	return t.name
+}
+func (t *Node) Parent() *Node {
+	if t == nil {
+		return nil
+	}
+	return t.parent
+}
+func (t *Node) Children() []*Node {
+	if t == nil {
+		return nil
+	}
+	return t.children
+}
+func (t *Node) Meta() map[string]any {
+	if t == nil {
+		return nil
+	}
+	return t.meta
+}
+func (t *Node) Timeout() time.Duration {
+	if t == nil {
+		var zero time.Duration
+		return zero
+	}
+	return t.timeout
+}
+func (t *Node) Created() time.Time {
+	if t == nil {
+		var zero time.Time
+		return zero
+	}
+	return t.created
+}
+func (t *Node) Cb() func() {
+	if t == nil {
+		return nil
+	}
+	return t.cb
+}
+
+// properties for Tree
+func (t *Tree) Root() *Node {
+	if t == nil {
+		return nil
+	}
+	return t.root
+}
+func (t *Tree) Size() *int {
+	if t == nil {
+		return nil
+	}
+	return &t.size
+}
+func (t *Tree) Label() string {
+	if t == nil {
+		var zero string
+		return zero
+	}
+	t.labelOnce.Do(func() {
+		t.label = t.loadLabel()
+	})
+	return t.label
+}
@@ -0,0 +1,26 @@
+package testdata
+
+import (
+	"fmt"
+	"time"
+)
+
+type Cache[K comparable, V any] struct {
+	items map[K]V    `get:""`
+	last  Pair[K, V] `prop:"&"`
+}
+
+func (c *Cache[K, V]) Len() int {
+	return len(c.items)
+}
+
+type Pair[K comparable, V any] struct {
+	key   K `get:""`
+	value V `get:"" set:""`
+}
+
+// Window 的类型约束引用了其他包的类型
+type Window[T ~int32 | time.Duration, S interface{ fmt.Stringer }] struct {
+	size  T `get:"" builder:"" options:"" iface:""`
+	label S `get:"" ctor:""`
+}
@@ -0,0 +1,100 @@
+package testdata
+
+import (
+	"fmt"
+	"time"
+)
+
+// properties for Cache
+func (c *Cache[K, V]) Items() map[K]V {
+	return c.items
+}
+func (c *Cache[K, V]) Last() *Pair[K, V] {
+	return &c.last
+}
+func (c *Cache[K, V]) SetLast(v Pair[K, V]) {
+	c.last = v
+}
+
+// properties for Pair
+func (t *Pair[K, V]) Key() K {
+	return t.key
+}
+func (t *Pair[K, V]) Value() V {
+	return t.value
+}
+func (t *Pair[K, V]) SetValue(v V) {
+	t.value = v
+}
+
+// properties for Window
+func (t *Window[T, S]) Size() T {
+	return t.size
+}
+func (t *Window[T, S]) Label() S {
+	return t.label
+}
+
+// interfaces for Window
+type WindowGetter[T ~int32 | time.Duration, S interface {
+	fmt.Stringer
+}] interface {
+	Size() T
+	Label() S
+}
+
+// constructors for Window
+func NewWindow[T ~int32 | time.Duration, S interface {
+	fmt.Stringer
+}](size T, label S) *Window[T, S] {
+	return &Window[T, S]{size: size, label: label}
+}
+
+// builder for Window
+type WindowBuilder[T ~int32 | time.Duration, S interface {
+	fmt.Stringer
+}] struct {
+	target Window[T, S]
+}
+
+func NewWindowBuilder[T ~int32 | time.Duration, S interface {
+	fmt.Stringer
+}]() *WindowBuilder[T, S] {
+	return &WindowBuilder[T, S]{}
+}
+func (b *WindowBuilder[T, S]) Size(v T) *WindowBuilder[T, S] {
+	b.target.size = v
+	return b
+}
+func (b *WindowBuilder[T, S]) Label(v S) *WindowBuilder[T, S] {
+	b.target.label = v
+	return b
+}
+func (b *WindowBuilder[T, S]) Build() (*Window[T, S], error) {
+	return &Window[T, S]{size: b.target.size, label: b.target.label}, nil
+}
+
+// options for Window
+type WindowOption[T ~int32 | time.Duration, S interface {
+	fmt.Stringer
+}] func(*Window[T, S])
+
+func WithSize[T ~int32 | time.Duration, S interface {
+	fmt.Stringer
+}](v T) WindowOption[T, S] {
+	return func(t *Window[T, S]) {
+		t.size = v
+	}
+}
+func WithLabel[T ~int32 | time.Duration, S interface {
+	fmt.Stringer
+}](v S) WindowOption[T, S] {
+	return func(t *Window[T, S]) {
+		t.label = v
+	}
+}
+func (t *Window[T, S]) applyOptions(opts ...WindowOption[T, S]) {
+	for _, opt := range opts {
+		opt(t)
+	}
+}
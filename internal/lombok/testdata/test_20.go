@@ -0,0 +1,25 @@
+package testdata
+
+import "time"
+
+type Timeout time.Duration
+
+type Level int
+
+type ServerConfig struct {
+	host     string        `get:"" default:"localhost"`
+	port     int           `get:"" default:"8080"`
+	mask     uint32        `get:"" default:"0x1F"`
+	ratio    float64       `get:"" default:"0.75"`
+	enabled  bool          `get:"" default:"true"`
+	interval time.Duration `get:"" default:"1500ms"`
+	idle     Timeout       `get:"" default:"2m"`
+	level    Level         `get:"@" set:"" default:"-1"`
+}
+
+//lombok:getter
+//lombok:nilsafe
+type ClientConfig struct {
+	retries int    `default:"3"`
+	name    string `default:"client"`
+}
@@ -0,0 +1,76 @@
+package testdata
+
+import "time"
+
+// properties for ClientConfig
+func (t *ClientConfig) Retries() int {
+	if t == nil {
+		return 3
+	}
+	if t.retries == 0 {
+		return 3
+	}
+	return t.retries
+}
+func (t *ClientConfig) Name() string {
+	if t == nil {
+		return "client"
+	}
+	if t.name == "" {
+		return "client"
+	}
+	return t.name
+}
+
+// properties for ServerConfig
+func (t *ServerConfig) Host() string {
+	if t.host == "" {
+		return "localhost"
+	}
+	return t.host
+}
+func (t *ServerConfig) Port() int {
+	if t.port == 0 {
+		return 8080
+	}
+	return t.port
+}
+func (t *ServerConfig) Mask() uint32 {
+	if t.mask == 0 {
+		return 31
+	}
+	return t.mask
+}
+func (t *ServerConfig) Ratio() float64 {
+	if t.ratio == 0 {
+		return 0.75
+	}
+	return t.ratio
+}
+func (t *ServerConfig) Enabled() bool {
+	if !t.enabled {
+		return true
+	}
+	return t.enabled
+}
+func (t *ServerConfig) Interval() time.Duration {
+	if t.interval == 0 {
+		return 1500 * time.Millisecond
+	}
+	return t.interval
+}
+func (t *ServerConfig) Idle() Timeout {
+	if t.idle == 0 {
+		return Timeout(2 * time.Minute)
+	}
+	return t.idle
+}
+func (t *ServerConfig) GetLevel() Level {
+	if t.level == 0 {
+		return -1
+	}
+	return t.level
+}
+func (t *ServerConfig) SetLevel(v Level) {
+	t.level = v
+}
@@ -0,0 +1,33 @@
+package testdata
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+type Logger interface {
+	Log(format string, args ...any)
+	Level() int
+}
+
+type Counter struct {
+	n int
+}
+
+func (c *Counter) Inc()         { c.n++ }
+func (c Counter) Value() int    { return c.n }
+func (c *Counter) reset()       { c.n = 0 }
+func (c *Counter) Name() string { return "counter" }
+
+type Service struct {
+	logger  Logger           `delegate:""`
+	reader  io.ReadCloser    `delegate:""`
+	counter Counter          `delegate:"" get:""`
+	builder *strings.Builder `delegate:""`
+	name    string           `get:""`
+}
+
+func (s *Service) Close() error { return nil }
+
+func (s *Service) describe() string { return fmt.Sprint(s.Level()) }
@@ -0,0 +1,51 @@
+package testdata
+
+// properties for Service
+func (s *Service) Level() int {
+	return s.logger.Level()
+}
+func (s *Service) Log(format string, args ...any) {
+	s.logger.Log(format, args...)
+}
+func (s *Service) Read(p []byte) (int, error) {
+	return s.reader.Read(p)
+}
+func (s *Service) Counter() Counter {
+	return s.counter
+}
+func (s *Service) Inc() {
+	s.counter.Inc()
+}
+func (s *Service) Value() int {
+	return s.counter.Value()
+}
+func (s *Service) Cap() int {
+	return s.builder.Cap()
+}
+func (s *Service) Grow(n int) {
+	s.builder.Grow(n)
+}
+func (s *Service) Len() int {
+	return s.builder.Len()
+}
+func (s *Service) Reset() {
+	s.builder.Reset()
+}
+func (s *Service) String() string {
+	return s.builder.String()
+}
+func (s *Service) Write(p []byte) (int, error) {
+	return s.builder.Write(p)
+}
+func (s *Service) WriteByte(c byte) error {
+	return s.builder.WriteByte(c)
+}
+func (s *Service) WriteRune(r rune) (int, error) {
+	return s.builder.WriteRune(r)
+}
+func (s *Service) WriteString(p0 string) (int, error) {
+	return s.builder.WriteString(p0)
+}
+func (s *Service) Name() string {
+	return s.name
+}
@@ -0,0 +1,31 @@
+package testdata
+
+import (
+	"errors"
+	"time"
+)
+
+//lombok:iface
+type Account struct {
+	id      int64     `get:""`
+	name    string    `get:"@" set:"!"`
+	email   string    `get:"" set:",validate"`
+	created time.Time `get:"&"`
+}
+
+func (a *Account) validateEmail(v string) error {
+	if v == "" {
+		return errors.New("empty email")
+	}
+	return nil
+}
+
+type Point struct {
+	x int `get:"" iface:""`
+	y int `get:""`
+}
+
+type Pair[K comparable, V any] struct {
+	key   K `get:"" iface:""`
+	value V `prop:""`
+}
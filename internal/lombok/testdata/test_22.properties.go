@@ -0,0 +1,80 @@
+package testdata
+
+import "time"
+
+// properties for Account
+func (a *Account) Id() int64 {
+	return a.id
+}
+func (a *Account) GetName() string {
+	return a.name
+}
+func (a *Account) SetName(v string) *Account {
+	a.name = v
+	return a
+}
+func (a *Account) Email() string {
+	return a.email
+}
+func (a *Account) SetEmail(v string) error {
+	if err := a.validateEmail(v); err != nil {
+		return err
+	}
+	a.email = v
+	return nil
+}
+func (a *Account) Created() *time.Time {
+	return &a.created
+}
+
+// interfaces for Account
+type AccountGetter interface {
+	Id() int64
+	GetName() string
+	Email() string
+	Created() *time.Time
+}
+type AccountAccessor interface {
+	AccountGetter
+	SetName(v string) *Account
+	SetEmail(v string) error
+}
+
+var _ AccountAccessor = (*Account)(nil)
+
+// properties for Pair
+func (t *Pair[K, V]) Key() K {
+	return t.key
+}
+func (t *Pair[K, V]) Value() V {
+	return t.value
+}
+func (t *Pair[K, V]) SetValue(v V) {
+	t.value = v
+}
+
+// interfaces for Pair
+type PairGetter[K comparable, V any] interface {
+	Key() K
+	Value() V
+}
+type PairAccessor[K comparable, V any] interface {
+	PairGetter[K, V]
+	SetValue(v V)
+}
+
+// properties for Point
+func (t *Point) X() int {
+	return t.x
+}
+func (t *Point) Y() int {
+	return t.y
+}
+
+// interfaces for Point
+type PointGetter interface {
+	X() int
+	Y() int
+}
+
+var _ PointGetter = (*Point)(nil)
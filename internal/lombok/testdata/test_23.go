@@ -0,0 +1,32 @@
+package testdata
+
+// Status 订单状态
+//
+//lombok:enum Status
+type Status int
+
+const (
+	StatusUnknown Status = iota
+	StatusActive
+	StatusDeleted
+	StatusRemoved = StatusDeleted // 别名
+)
+
+//lombok:enum
+type permission uint8
+
+const (
+	permRead permission = 1 << iota
+	permWrite
+	_
+	permExec
+)
+
+func (p permission) String() string { return "perm" }
+
+type Level int
+
+const (
+	LevelLow Level = iota
+	LevelHigh
+)
@@ -0,0 +1,101 @@
+package testdata
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// enum methods for Status
+func (t Status) String() string {
+	switch t {
+	case StatusUnknown:
+		return "Unknown"
+	case StatusActive:
+		return "Active"
+	case StatusDeleted:
+		return "Deleted"
+	}
+	return "Status(" + strconv.FormatInt(int64(t), 10) + ")"
+}
+func ParseStatus(s string) (Status, error) {
+	switch s {
+	case "Unknown":
+		return StatusUnknown, nil
+	case "Active":
+		return StatusActive, nil
+	case "Deleted":
+		return StatusDeleted, nil
+	}
+	return 0, fmt.Errorf("invalid Status: %q", s)
+}
+func StatusValues() []Status {
+	return []Status{StatusUnknown, StatusActive, StatusDeleted}
+}
+func (t Status) IsValid() bool {
+	switch t {
+	case StatusUnknown, StatusActive, StatusDeleted:
+		return true
+	}
+	return false
+}
+func (t Status) MarshalText() ([]byte, error) {
+	switch t {
+	case StatusUnknown:
+		return []byte("Unknown"), nil
+	case StatusActive:
+		return []byte("Active"), nil
+	case StatusDeleted:
+		return []byte("Deleted"), nil
+	}
+	return nil, fmt.Errorf("invalid Status: %d", t)
+}
+func (t *Status) UnmarshalText(text []byte) error {
+	v, err := ParseStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
+
+// enum methods for permission
+func parsePermission(s string) (permission, error) {
+	switch s {
+	case "permRead":
+		return permRead, nil
+	case "permWrite":
+		return permWrite, nil
+	case "permExec":
+		return permExec, nil
+	}
+	return 0, fmt.Errorf("invalid permission: %q", s)
+}
+func permissionValues() []permission {
+	return []permission{permRead, permWrite, permExec}
+}
+func (p permission) IsValid() bool {
+	switch p {
+	case permRead, permWrite, permExec:
+		return true
+	}
+	return false
+}
+func (p permission) MarshalText() ([]byte, error) {
+	switch p {
+	case permRead:
+		return []byte("permRead"), nil
+	case permWrite:
+		return []byte("permWrite"), nil
+	case permExec:
+		return []byte("permExec"), nil
+	}
+	return nil, fmt.Errorf("invalid permission: %d", p)
+}
+func (p *permission) UnmarshalText(text []byte) error {
+	v, err := parsePermission(string(text))
+	if err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
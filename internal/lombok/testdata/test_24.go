@@ -0,0 +1,31 @@
+package testdata
+
+import "time"
+
+//lombok:options
+type Server struct {
+	addr    string        `get:""`
+	timeout time.Duration `get:""`
+	tls     bool          `required:""`
+	handler func()
+}
+
+// WithAddr 手写的同名函数，不再生成
+func WithAddr(addr string) ServerOption {
+	return func(s *Server) { s.addr = addr }
+}
+
+func NewServer(opts ...ServerOption) *Server {
+	s := &Server{addr: ":8080"}
+	s.applyOptions(opts...)
+	return s
+}
+
+type Pool[T any] struct {
+	size int `get:"" options:"Pool"`
+	init func() T
+}
+
+type client struct {
+	retries int `get:"" options:""`
+}
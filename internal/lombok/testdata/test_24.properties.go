@@ -0,0 +1,68 @@
+package testdata
+
+import "time"
+
+// properties for Pool
+func (t *Pool[T]) Size() int {
+	return t.size
+}
+
+// options for Pool
+type PoolOption[T any] func(*Pool[T])
+
+func WithPoolSize[T any](v int) PoolOption[T] {
+	return func(t *Pool[T]) {
+		t.size = v
+	}
+}
+func (t *Pool[T]) applyOptions(opts ...PoolOption[T]) {
+	for _, opt := range opts {
+		opt(t)
+	}
+}
+
+// properties for Server
+func (t *Server) Addr() string {
+	return t.addr
+}
+func (t *Server) Timeout() time.Duration {
+	return t.timeout
+}
+
+// options for Server
+type ServerOption func(*Server)
+
+func WithTimeout(v time.Duration) ServerOption {
+	return func(t *Server) {
+		t.timeout = v
+	}
+}
+func WithTls(v bool) ServerOption {
+	return func(t *Server) {
+		t.tls = v
+	}
+}
+func (t *Server) applyOptions(opts ...ServerOption) {
+	for _, opt := range opts {
+		opt(t)
+	}
+}
+
+// properties for client
+func (t *client) Retries() int {
+	return t.retries
+}
+
+// options for client
+type clientOption func(*client)
+
+func withRetries(v int) clientOption {
+	return func(t *client) {
+		t.retries = v
+	}
+}
+func (t *client) applyOptions(opts ...clientOption) {
+	for _, opt := range opts {
+		opt(t)
+	}
+}
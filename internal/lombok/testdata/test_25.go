@@ -0,0 +1,37 @@
+package testdata
+
+import "strconv"
+
+// Coord 以值保存并用作 map 的键，recv tag 显式指定值接收者
+type Coord struct {
+	x int `get:"" with:"" recv:"c,value"`
+	y int `get:"" with:"" tostring:""`
+}
+
+// Money 已有的方法均为值接收者，推断为值接收者
+type Money struct {
+	amount   int64  `get:"" default:"100"`
+	currency string `get:"" with:""`
+}
+
+func (m Money) String() string {
+	return strconv.FormatInt(m.amount, 10) + " " + m.currency
+}
+
+// Counter 存在 setter，不推断为值接收者
+type Counter struct {
+	n int `get:"" set:""`
+}
+
+func (c Counter) Double() int {
+	return c.n * 2
+}
+
+// Label 已有值接收者方法，recv tag 指定指针接收者
+type Label struct {
+	text string `get:"" recv:",pointer"`
+}
+
+func (l Label) Len() int {
+	return len(l.text)
+}
@@ -0,0 +1,55 @@
+package testdata
+
+import "fmt"
+
+// properties for Coord
+func (c Coord) X() int {
+	return c.x
+}
+func (c Coord) WithX(v int) Coord {
+	c.x = v
+	return c
+}
+func (c Coord) Y() int {
+	return c.y
+}
+func (c Coord) WithY(v int) Coord {
+	c.y = v
+	return c
+}
+
+// string methods for Coord
+func (c Coord) String() string {
+	return fmt.Sprintf("Coord{x=%v, y=%v}", c.x, c.y)
+}
+func (c Coord) GoString() string {
+	return fmt.Sprintf("Coord{x:%#v, y:%#v}", c.x, c.y)
+}
+
+// properties for Counter
+func (c *Counter) N() int {
+	return c.n
+}
+func (c *Counter) SetN(v int) {
+	c.n = v
+}
+
+// properties for Label
+func (l *Label) Text() string {
+	return l.text
+}
+
+// properties for Money
+func (m Money) Amount() int64 {
+	if m.amount == 0 {
+		return 100
+	}
+	return m.amount
+}
+func (m Money) Currency() string {
+	return m.currency
+}
+func (m Money) WithCurrency(v string) Money {
+	m.currency = v
+	return m
+}
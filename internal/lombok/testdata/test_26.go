@@ -0,0 +1,7 @@
+package testdata
+
+// Index 仅包含 map 类型的属性，Clone 只使用 maps.Clone
+type Index struct {
+	items map[string]int `clone:""`
+	names map[int]string
+}
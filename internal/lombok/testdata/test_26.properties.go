@@ -0,0 +1,14 @@
+package testdata
+
+import "maps"
+
+// clone method for Index
+func (t *Index) Clone() *Index {
+	if t == nil {
+		return nil
+	}
+	c := &Index{}
+	c.items = maps.Clone(t.items)
+	c.names = maps.Clone(t.names)
+	return c
+}
@@ -0,0 +1,6 @@
+package testdata
+
+// Headers 仅复制 map 类型的属性，只导入 maps
+type Headers struct {
+	values map[string][]string `get:",copy" set:",copy"`
+}
@@ -0,0 +1,11 @@
+package testdata
+
+import "maps"
+
+// properties for Headers
+func (t *Headers) Values() map[string][]string {
+	return maps.Clone(t.values)
+}
+func (t *Headers) SetValues(v map[string][]string) {
+	t.values = maps.Clone(v)
+}
@@ -0,0 +1,13 @@
+package testdata
+
+import "github.com/heyuuu/go-lombok/internal/lombok/testdata/meta"
+
+type Base struct {
+	id int64 `get:""`
+}
+
+type Entity struct {
+	Base       `get:"@" set:""`
+	*meta.Meta `prop:"&@"`
+	name       string `prop:""`
+}
@@ -0,0 +1,28 @@
+package testdata
+
+import "github.com/heyuuu/go-lombok/internal/lombok/testdata/meta"
+
+// properties for Base
+func (t *Base) Id() int64 {
+	return t.id
+}
+
+// properties for Entity
+func (t *Entity) GetBase() Base {
+	return t.Base
+}
+func (t *Entity) SetBase(v Base) {
+	t.Base = v
+}
+func (t *Entity) GetMeta() **meta.Meta {
+	return &t.Meta
+}
+func (t *Entity) SetMeta(v *meta.Meta) {
+	t.Meta = v
+}
+func (t *Entity) Name() string {
+	return t.name
+}
+func (t *Entity) SetName(v string) {
+	t.name = v
+}
@@ -0,0 +1,15 @@
+package testdata
+
+import "time"
+
+type Config struct {
+	host    string        `get:"" builder:"" required:""`
+	port    int           `get:"" required:""`
+	timeout time.Duration `prop:""`
+	tags    []string      `get:""`
+	cache   map[string]int
+}
+
+type Pool[T any] struct {
+	items []T `get:"" builder:""`
+}
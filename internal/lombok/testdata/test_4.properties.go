@@ -0,0 +1,81 @@
+package testdata
+
+import (
+	"errors"
+	"time"
+)
+
+// properties for Config
+func (t *Config) Host() string {
+	return t.host
+}
+func (t *Config) Port() int {
+	return t.port
+}
+func (t *Config) Timeout() time.Duration {
+	return t.timeout
+}
+func (t *Config) SetTimeout(v time.Duration) {
+	t.timeout = v
+}
+func (t *Config) Tags() []string {
+	return t.tags
+}
+
+// builder for Config
+type ConfigBuilder struct {
+	target Config
+	isSet  [2]bool
+}
+
+func NewConfigBuilder() *ConfigBuilder {
+	return &ConfigBuilder{}
+}
+func (b *ConfigBuilder) Host(v string) *ConfigBuilder {
+	b.target.host = v
+	b.isSet[0] = true
+	return b
+}
+func (b *ConfigBuilder) Port(v int) *ConfigBuilder {
+	b.target.port = v
+	b.isSet[1] = true
+	return b
+}
+func (b *ConfigBuilder) Timeout(v time.Duration) *ConfigBuilder {
+	b.target.timeout = v
+	return b
+}
+func (b *ConfigBuilder) Tags(v []string) *ConfigBuilder {
+	b.target.tags = v
+	return b
+}
+func (b *ConfigBuilder) Build() (*Config, error) {
+	if !b.isSet[0] {
+		return nil, errors.New("Config.host is required")
+	}
+	if !b.isSet[1] {
+		return nil, errors.New("Config.port is required")
+	}
+	return &Config{host: b.target.host, port: b.target.port, timeout: b.target.timeout, tags: b.target.tags}, nil
+}
+
+// properties for Pool
+func (t *Pool[T]) Items() []T {
+	return t.items
+}
+
+// builder for Pool
+type PoolBuilder[T any] struct {
+	target Pool[T]
+}
+
+func NewPoolBuilder[T any]() *PoolBuilder[T] {
+	return &PoolBuilder[T]{}
+}
+func (b *PoolBuilder[T]) Items(v []T) *PoolBuilder[T] {
+	b.target.items = v
+	return b
+}
+func (b *PoolBuilder[T]) Build() (*Pool[T], error) {
+	return &Pool[T]{items: b.target.items}, nil
+}
@@ -0,0 +1,27 @@
+package testdata
+
+import "time"
+
+type Server struct {
+	host    string        `get:"" required:"" ctor:"all,required"`
+	port    int           `get:"" required:""`
+	timeout time.Duration `prop:""`
+	stats   map[string]int
+}
+
+type client struct {
+	addr string `get:"" ctor:""`
+}
+
+type Handler struct {
+	name string `get:"" ctor:""`
+}
+
+func NewHandler(name string) *Handler {
+	return &Handler{name: name}
+}
+
+type Pair[K comparable, V any] struct {
+	key   K `get:"" ctor:""`
+	value V `get:""`
+}
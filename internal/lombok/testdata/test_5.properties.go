@@ -0,0 +1,53 @@
+package testdata
+
+import "time"
+
+// properties for Handler
+func (t *Handler) Name() string {
+	return t.name
+}
+
+// properties for Pair
+func (t *Pair[K, V]) Key() K {
+	return t.key
+}
+func (t *Pair[K, V]) Value() V {
+	return t.value
+}
+
+// constructors for Pair
+func NewPair[K comparable, V any](key K, value V) *Pair[K, V] {
+	return &Pair[K, V]{key: key, value: value}
+}
+
+// properties for Server
+func (t *Server) Host() string {
+	return t.host
+}
+func (t *Server) Port() int {
+	return t.port
+}
+func (t *Server) Timeout() time.Duration {
+	return t.timeout
+}
+func (t *Server) SetTimeout(v time.Duration) {
+	t.timeout = v
+}
+
+// constructors for Server
+func NewServer(host string, port int, timeout time.Duration) *Server {
+	return &Server{host: host, port: port, timeout: timeout}
+}
+func NewServerRequired(host string, port int) *Server {
+	return &Server{host: host, port: port}
+}
+
+// properties for client
+func (t *client) Addr() string {
+	return t.addr
+}
+
+// constructors for client
+func newClient(addr string) *client {
+	return &client{addr: addr}
+}
@@ -0,0 +1,9 @@
+package testdata
+
+import "time"
+
+type Snapshot struct {
+	version int           `get:"" with:""`
+	timeout time.Duration `get:"" with:"WithTTL"`
+	labels  map[string]string
+}
@@ -0,0 +1,21 @@
+package testdata
+
+import "time"
+
+// properties for Snapshot
+func (t *Snapshot) Version() int {
+	return t.version
+}
+func (t *Snapshot) WithVersion(v int) *Snapshot {
+	cp := *t
+	cp.version = v
+	return &cp
+}
+func (t *Snapshot) Timeout() time.Duration {
+	return t.timeout
+}
+func (t *Snapshot) WithTTL(v time.Duration) *Snapshot {
+	cp := *t
+	cp.timeout = v
+	return &cp
+}
@@ -0,0 +1,47 @@
+package testdata
+
+import (
+	"fmt"
+	"sync"
+)
+
+type Account struct {
+	name     string `get:"" tostring:""`
+	password string `tostring:"sensitive"`
+	cache    []byte `tostring:"-"`
+	Base
+}
+
+type Base struct {
+	id int64
+}
+
+type Token struct {
+	value string `tostring:"sensitive"`
+}
+
+func (t Token) String() string {
+	return fmt.Sprint("Token(", len(t.value), ")")
+}
+
+type Creds struct {
+	user     string
+	password string `tostring:"sensitive"`
+}
+
+type Session struct {
+	creds Creds `tostring:""`
+	token Token
+	peer  *Creds
+}
+
+// Meter 的 stats 字段包含锁，以指针格式化避免复制锁
+type Meter struct {
+	name  string `tostring:""`
+	stats Stats
+}
+
+type Stats struct {
+	mu    sync.Mutex
+	count int
+}
@@ -0,0 +1,45 @@
+package testdata
+
+import "fmt"
+
+// properties for Account
+func (t *Account) Name() string {
+	return t.name
+}
+
+// string methods for Account
+func (t *Account) String() string {
+	return fmt.Sprintf("Account{name=%v, password=***, Base=%v}", t.name, t.Base)
+}
+func (t *Account) GoString() string {
+	return fmt.Sprintf("Account{name:%#v, password:\"***\", Base:%#v}", t.name, t.Base)
+}
+
+// string methods for Creds
+func (t *Creds) String() string {
+	return fmt.Sprintf("Creds{user=%v, password=***}", t.user)
+}
+func (t *Creds) GoString() string {
+	return fmt.Sprintf("Creds{user:%#v, password:\"***\"}", t.user)
+}
+
+// string methods for Meter
+func (t *Meter) String() string {
+	return fmt.Sprintf("Meter{name=%v, stats=%v}", t.name, &t.stats)
+}
+func (t *Meter) GoString() string {
+	return fmt.Sprintf("Meter{name:%#v, stats:%#v}", t.name, &t.stats)
+}
+
+// string methods for Session
+func (t *Session) String() string {
+	return fmt.Sprintf("Session{creds=%v, token=%v, peer=%v}", &t.creds, &t.token, t.peer)
+}
+func (t *Session) GoString() string {
+	return fmt.Sprintf("Session{creds:%#v, token:%#v, peer:%#v}", &t.creds, &t.token, t.peer)
+}
+
+// string methods for Token
+func (t Token) GoString() string {
+	return "Token{value:\"***\"}"
+}
@@ -0,0 +1,62 @@
+package testdata
+
+import (
+	"sync"
+	"time"
+)
+
+type Status int
+
+type Point struct {
+	x int `get:"" equal:"hash"`
+	y int `get:""`
+}
+
+type Shape struct {
+	name     string             `get:"" equal:"hash"`
+	status   Status             `get:""`
+	center   Point              `get:""`
+	origin   *Point             `get:""`
+	points   []Point            `get:""`
+	tags     []string           `get:""`
+	attrs    map[string]float64 `get:""`
+	created  time.Time          `get:""`
+	history  []time.Time
+	timeout  time.Duration
+	callback func() `equal:"-"`
+}
+
+// Money 手写的 Equal 参数为值类型
+type Money struct {
+	amount   int64
+	currency string
+	rates    []float64
+}
+
+func (m Money) Equal(o Money) bool {
+	return m.amount == o.amount && m.currency == o.currency
+}
+
+type Order struct {
+	price  Money            `equal:""`
+	items  []Money          `get:""`
+	totals map[string]Money `get:""`
+}
+
+// Meter 的字段包含锁，比较时传入指针避免复制锁
+type Meter struct {
+	name    string `equal:"hash"`
+	stats   Stats
+	history []Stats
+	gauge   Gauge
+}
+
+type Stats struct {
+	mu    sync.Mutex
+	count int `equal:"hash"`
+}
+
+type Gauge struct {
+	mu    sync.RWMutex
+	value float64
+}
@@ -0,0 +1,213 @@
+package testdata
+
+import (
+	"hash/maphash"
+	"maps"
+	"reflect"
+	"slices"
+	"time"
+)
+
+// equal methods for Meter
+func (t *Meter) Equal(other *Meter) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	if t.name != other.name {
+		return false
+	}
+	if !t.stats.Equal(&other.stats) {
+		return false
+	}
+	if !reflect.DeepEqual(t.history, other.history) {
+		return false
+	}
+	if !reflect.DeepEqual(&t.gauge, &other.gauge) {
+		return false
+	}
+	return true
+}
+func (t *Meter) Hash() uint64 {
+	if t == nil {
+		return 0
+	}
+	var h maphash.Hash
+	h.SetSeed(lombokHashSeed)
+	maphash.WriteComparable(&h, t.name)
+	maphash.WriteComparable(&h, t.stats.Hash())
+	maphash.WriteComparable(&h, len(t.history))
+	return h.Sum64()
+}
+
+// properties for Order
+func (t *Order) Items() []Money {
+	return t.items
+}
+func (t *Order) Totals() map[string]Money {
+	return t.totals
+}
+
+// equal methods for Order
+func (t *Order) Equal(other *Order) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	if !t.price.Equal(other.price) {
+		return false
+	}
+	if !slices.EqualFunc(t.items, other.items, func(a, b Money) bool {
+		return a.Equal(b)
+	}) {
+		return false
+	}
+	if !maps.EqualFunc(t.totals, other.totals, func(a, b Money) bool {
+		return a.Equal(b)
+	}) {
+		return false
+	}
+	return true
+}
+
+// properties for Point
+func (t *Point) X() int {
+	return t.x
+}
+func (t *Point) Y() int {
+	return t.y
+}
+
+// equal methods for Point
+func (t *Point) Equal(other *Point) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	if t.x != other.x {
+		return false
+	}
+	if t.y != other.y {
+		return false
+	}
+	return true
+}
+func (t *Point) Hash() uint64 {
+	if t == nil {
+		return 0
+	}
+	var h maphash.Hash
+	h.SetSeed(lombokHashSeed)
+	maphash.WriteComparable(&h, t.x)
+	maphash.WriteComparable(&h, t.y)
+	return h.Sum64()
+}
+
+// properties for Shape
+func (t *Shape) Name() string {
+	return t.name
+}
+func (t *Shape) Status() Status {
+	return t.status
+}
+func (t *Shape) Center() Point {
+	return t.center
+}
+func (t *Shape) Origin() *Point {
+	return t.origin
+}
+func (t *Shape) Points() []Point {
+	return t.points
+}
+func (t *Shape) Tags() []string {
+	return t.tags
+}
+func (t *Shape) Attrs() map[string]float64 {
+	return t.attrs
+}
+func (t *Shape) Created() time.Time {
+	return t.created
+}
+
+// equal methods for Shape
+func (t *Shape) Equal(other *Shape) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	if t.name != other.name {
+		return false
+	}
+	if t.status != other.status {
+		return false
+	}
+	if !t.center.Equal(&other.center) {
+		return false
+	}
+	if !t.origin.Equal(other.origin) {
+		return false
+	}
+	if !slices.EqualFunc(t.points, other.points, func(a, b Point) bool {
+		return a.Equal(&b)
+	}) {
+		return false
+	}
+	if !slices.Equal(t.tags, other.tags) {
+		return false
+	}
+	if !maps.Equal(t.attrs, other.attrs) {
+		return false
+	}
+	if !t.created.Equal(other.created) {
+		return false
+	}
+	if !slices.EqualFunc(t.history, other.history, func(a, b time.Time) bool {
+		return a.Equal(b)
+	}) {
+		return false
+	}
+	if t.timeout != other.timeout {
+		return false
+	}
+	return true
+}
+func (t *Shape) Hash() uint64 {
+	if t == nil {
+		return 0
+	}
+	var h maphash.Hash
+	h.SetSeed(lombokHashSeed)
+	maphash.WriteComparable(&h, t.name)
+	maphash.WriteComparable(&h, t.status)
+	maphash.WriteComparable(&h, t.center.Hash())
+	maphash.WriteComparable(&h, t.origin.Hash())
+	for _, e := range t.points {
+		maphash.WriteComparable(&h, e.Hash())
+	}
+	for _, e := range t.tags {
+		maphash.WriteComparable(&h, e)
+	}
+	maphash.WriteComparable(&h, len(t.attrs))
+	maphash.WriteComparable(&h, len(t.history))
+	maphash.WriteComparable(&h, t.timeout)
+	return h.Sum64()
+}
+
+// equal methods for Stats
+func (t *Stats) Equal(other *Stats) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	if t.count != other.count {
+		return false
+	}
+	return true
+}
+func (t *Stats) Hash() uint64 {
+	if t == nil {
+		return 0
+	}
+	var h maphash.Hash
+	h.SetSeed(lombokHashSeed)
+	maphash.WriteComparable(&h, t.count)
+	return h.Sum64()
+}
+
+// hash seed for generated Hash methods
+var lombokHashSeed = maphash.MakeSeed()
@@ -0,0 +1,45 @@
+package testdata
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type Node struct {
+	name     string          `get:"" clone:""`
+	children []*Node         `get:""`
+	parent   *Node           `clone:"shallow"`
+	attrs    map[string]Attr `get:""`
+	tags     []string        `get:""`
+	index    map[string]int  `get:""`
+	meta     Attr            `get:""`
+	extra    *Extra          `get:""`
+	mu       sync.Mutex
+}
+
+type Attr struct {
+	values []int `get:"" clone:""`
+}
+
+type Extra struct {
+	note string
+}
+
+// Point 手写的值接收者 Clone 返回值类型
+type Point struct {
+	x, y int
+}
+
+func (p Point) Clone() Point {
+	return p
+}
+
+type Counter struct {
+	origin  Point `clone:""`
+	points  []Point
+	last    *Point
+	hits    atomic.Int64
+	current atomic.Pointer[Point]
+	payload atomic.Value
+	mu      sync.RWMutex
+}
@@ -0,0 +1,93 @@
+package testdata
+
+import (
+	"maps"
+	"slices"
+)
+
+// properties for Attr
+func (t *Attr) Values() []int {
+	return t.values
+}
+
+// clone method for Attr
+func (t *Attr) Clone() *Attr {
+	if t == nil {
+		return nil
+	}
+	c := &Attr{}
+	c.values = slices.Clone(t.values)
+	return c
+}
+
+// clone method for Counter
+func (t *Counter) Clone() *Counter {
+	if t == nil {
+		return nil
+	}
+	c := &Counter{}
+	c.origin = t.origin.Clone()
+	if t.points != nil {
+		c.points = make([]Point, len(t.points))
+		for k, e := range t.points {
+			c.points[k] = e.Clone()
+		}
+	}
+	c.last = t.last
+	c.hits.Store(t.hits.Load())
+	c.current.Store(t.current.Load())
+	if v := t.payload.Load(); v != nil {
+		c.payload.Store(v)
+	}
+	return c
+}
+
+// properties for Node
+func (t *Node) Name() string {
+	return t.name
+}
+func (t *Node) Children() []*Node {
+	return t.children
+}
+func (t *Node) Attrs() map[string]Attr {
+	return t.attrs
+}
+func (t *Node) Tags() []string {
+	return t.tags
+}
+func (t *Node) Index() map[string]int {
+	return t.index
+}
+func (t *Node) Meta() Attr {
+	return t.meta
+}
+func (t *Node) Extra() *Extra {
+	return t.extra
+}
+
+// clone method for Node
+func (t *Node) Clone() *Node {
+	if t == nil {
+		return nil
+	}
+	c := &Node{}
+	c.name = t.name
+	if t.children != nil {
+		c.children = make([]*Node, len(t.children))
+		for k, e := range t.children {
+			c.children[k] = e.Clone()
+		}
+	}
+	c.parent = t.parent
+	if t.attrs != nil {
+		c.attrs = make(map[string]Attr, len(t.attrs))
+		for k, e := range t.attrs {
+			c.attrs[k] = *e.Clone()
+		}
+	}
+	c.tags = slices.Clone(t.tags)
+	c.index = maps.Clone(t.index)
+	c.meta = *t.meta.Clone()
+	c.extra = t.extra
+	return c
+}
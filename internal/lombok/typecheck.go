@@ -0,0 +1,62 @@
+package lombok
+
+import (
+	"errors"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// typeCheck 使用 go/types 对包进行类型检查，结果在同一 scanner 内缓存
+// scanner 会修改 ast 中的类型表达式，因此需重新解析源码。依赖包从源码导入，按源文件所在目录解析 go.mod，
+// 可导入本模块及依赖模块中的包；导入失败时返回错误。
+// 生成文件中的方法等未解析的引用会导致其他类型错误，这些错误被忽略，调用方需自行判断所需的类型是否已成功解析
+func (sc *scanner) typeCheck() (*types.Package, error) {
+	if sc.typesPkg != nil || sc.typesErr != nil {
+		return sc.typesPkg, sc.typesErr
+	}
+
+	// 源码导入器以文件所在目录解析导入路径，代码字符串视为位于当前目录
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, src := range sc.sources {
+		filename := filepath.Join(wd, "source.go")
+		if src.file != "" {
+			filename, err = filepath.Abs(src.file)
+			if err != nil {
+				return nil, err
+			}
+		}
+		file, err := parser.ParseFile(fset, filename, src.code, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	var importErrs []error
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			if typeErr, ok := err.(types.Error); ok && strings.HasPrefix(typeErr.Msg, "could not import ") {
+				importErrs = append(importErrs, err)
+			}
+		},
+	}
+	tpkg, _ := conf.Check(sc.pkg.Pkg, fset, files, nil)
+	if len(importErrs) > 0 {
+		sc.typesErr = errors.Join(importErrs...)
+		return nil, sc.typesErr
+	}
+	sc.typesPkg = tpkg
+	return tpkg, nil
+}
@@ -12,7 +12,9 @@ type PkgInfo struct {
 	Name string
 	Pkg  string
 	// private
-	types map[string]*Type
+	types         map[string]*Type
+	existingFuncs map[string]bool             // 已存在的包级函数名
+	foreignEqual  map[string]foreignEqualKind // 其他包的命名类型(包路径.类型名)的比较方式，见 resolveForeignEquals
 }
 
 func NewPkgInfo(pkg string) *PkgInfo {
@@ -21,9 +23,10 @@ func NewPkgInfo(pkg string) *PkgInfo {
 		name = name[idx+1:]
 	}
 	return &PkgInfo{
-		Name:  name,
-		Pkg:   pkg,
-		types: make(map[string]*Type),
+		Name:          name,
+		Pkg:           pkg,
+		types:         make(map[string]*Type),
+		existingFuncs: make(map[string]bool),
 	}
 }
 
@@ -41,6 +44,14 @@ func (pkg *PkgInfo) FindOrInitType(typeName string) *Type {
 	}
 }
 
+func (pkg *PkgInfo) RecordExistingFunc(name string) {
+	pkg.existingFuncs[name] = true
+}
+
+func (pkg *PkgInfo) ExistsFunc(name string) bool {
+	return pkg.existingFuncs[name]
+}
+
 func (pkg *PkgInfo) SortedTypes() []*Type {
 	types := slices.Collect(maps.Values(pkg.types))
 	slices.SortFunc(types, func(a, b *Type) int {
@@ -50,12 +61,37 @@ func (pkg *PkgInfo) SortedTypes() []*Type {
 }
 
 type Type struct {
-	Name     string
-	RecvName string
+	Name             string
+	RecvName         string
+	RecvKind         string         // recv tag 指定的接收者类型: value / pointer，为空时按已有方法推断
+	ValueRecv        bool           // 生成的属性方法是否使用值接收者，由 RecvKind 及已有方法的接收者确定
+	TypeParams       *ast.FieldList // 泛型类型参数列表，非泛型类型为 nil
+	Underlying       ast.Expr       // 类型定义的底层类型表达式，如 struct{...} / int
+	Builder          bool           // 是否生成 Builder 类型
+	AllArgsCtor      bool           // 是否生成全参数构造函数 NewT
+	RequiredArgsCtor bool           // 是否生成必填参数构造函数 NewTRequired
+	ToString         bool           // 是否生成 String / GoString 方法
+	Equal            bool           // 是否生成 Equal 方法
+	Hash             bool           // 是否生成 Hash 方法
+	Clone            bool           // 是否生成 Clone 方法
+	DirtyField       string         // 脏字段跟踪的掩码字段名，为空时不跟踪
+	Guard            string         // 保护 getter/setter 的锁字段名(sync.Mutex 或 sync.RWMutex)，为空时不加锁
+	NilSafe          bool           // getter 是否在 recv 为 nil 时返回零值
+	Interface        bool           // 是否生成 getter 接口 TGetter 及 getter + setter 接口 TAccessor
+	Enum             bool           // 是否为枚举类型(//lombok:enum 标记的整数类型)，生成 String / ParseT 等方法
+	EnumTrimPrefix   string         // 枚举常量名转换为字符串时去除的前缀
+	Options          bool           // 是否生成函数式选项 TOption 及 WithX 函数
+	OptionsPrefix    string         // 函数式选项函数名中 With 之后的前缀，用于区分同包中多个类型的选项
 	// private
 	propertyNames   []string // 属性名列表，按类型定义字段顺序
 	propertyMap     map[string]*Property
 	existsRecvNames map[string]bool // 已存在的 recv 名
+	existsValueRecv bool            // 是否存在值接收者的方法
+	existsPtrRecv   bool            // 是否存在指针接收者的方法
+	existingMethods map[string]bool // 已存在的方法名
+	cloneResult     ast.Expr        // 已存在的 Clone() 方法的返回值类型，用于判断返回 *T 还是 T
+	equalParam      ast.Expr        // 已存在的 Equal(other) bool 方法的参数类型，用于判断参数为 *T 还是 T
+	enumValues      []string        // 枚举常量名，按定义顺序，值重复的常量只保留第一个
 }
 
 func NewType(name string) *Type {
@@ -64,9 +100,25 @@ func NewType(name string) *Type {
 		propertyNames:   nil,
 		propertyMap:     make(map[string]*Property),
 		existsRecvNames: make(map[string]bool),
+		existingMethods: make(map[string]bool),
 	}
 }
 
+// TypeParamNames 返回泛型类型参数名列表，按定义顺序
+func (typ *Type) TypeParamNames() []string {
+	if typ.TypeParams == nil {
+		return nil
+	}
+
+	var names []string
+	for _, field := range typ.TypeParams.List {
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+	}
+	return names
+}
+
 func (typ *Type) AddProperty(propName string) *Property {
 	typ.propertyNames = append(typ.propertyNames, propName)
 	return typ.FindOrInitProperty(propName)
@@ -97,10 +149,27 @@ func (typ *Type) Properties() iter.Seq[*Property] {
 	}
 }
 
+func (typ *Type) RecordExistingMethod(name string) {
+	typ.existingMethods[name] = true
+}
+
+func (typ *Type) ExistsMethod(name string) bool {
+	return typ.existingMethods[name]
+}
+
 func (typ *Type) RecordExistsRecvName(name string) {
 	typ.existsRecvNames[name] = true
 }
 
+// RecordExistingRecvKind 记录已存在方法的接收者类型
+func (typ *Type) RecordExistingRecvKind(isPtr bool) {
+	if isPtr {
+		typ.existsPtrRecv = true
+	} else {
+		typ.existsValueRecv = true
+	}
+}
+
 func (typ *Type) ExistsRecvName() (string, bool) {
 	if len(typ.existsRecvNames) == 1 {
 		for name, _ := range typ.existsRecvNames {
@@ -111,16 +180,41 @@ func (typ *Type) ExistsRecvName() (string, bool) {
 }
 
 type Property struct {
-	Name        string
-	Getter      string
-	IsRefGetter bool
-	Setter      string
-	Tag         string
-	Type        ast.Expr
+	Name           string
+	Getter         string
+	IsRefGetter    bool
+	LazyLoader     string // 延迟加载 getter 首次访问时调用的加载方法名，签名为 func() X
+	LazyOnce       string // 延迟加载使用的 sync.Once 字段名
+	CopyGetter     bool   // getter 是否返回 slice / map 属性的副本
+	Default        string // getter 在属性为零值时返回的默认值，为 default tag 的原始值
+	Setter         string
+	IsChainSetter  bool   // setter 是否返回 recv 以支持链式调用
+	CopySetter     bool   // setter 是否保存 slice / map 参数的副本
+	SetValidator   string // setter 赋值前调用的校验方法名，签名为 func(v X) error
+	AfterSetHook   string // setter 赋值后调用的方法名，签名为 func(old, new X)
+	AtomicAdder    string // sync/atomic 整数类型属性的 AddX 方法名
+	AtomicCAS      string // sync/atomic 类型属性的 CompareAndSwapX 方法名
+	Wither         string // 返回修改后副本的 wither 方法名
+	Coll           string // 集合辅助方法名中的属性部分，如 Items => AddItems / ItemsLen，为空时不生成
+	Iter           string // 返回 iter.Seq / iter.Seq2 只读迭代器的方法名，为空时不生成
+	Delegate       bool   // 是否为属性类型的方法集生成转发方法
+	Tag            string
+	Type           ast.Expr
+	Embedded       bool // 是否为嵌入字段，此时 Name 为嵌入类型名
+	Required       bool // 是否为必填属性，用于 Builder 等构造场景
+	StringExcluded bool // 是否在生成的 String 方法中排除
+	Sensitive      bool // 是否为敏感属性，生成的 String 方法中掩码输出
+	EqualExcluded  bool // 是否在生成的 Equal / Hash 方法中排除
+	ShallowClone   bool // 生成的 Clone 方法中是否直接赋值而不深拷贝
+	Ignored        bool // 是否被 //lombok:ignore 指令标记，不受类型指令影响
 
 	// private
 	existingGetters []string
 	existingSetters []string
+	chainSetters    map[string]bool  // 已存在的 setter 中返回 recv 的链式 setter
+	directiveGetter bool             // getter 是否来自 //lombok:getter 等类型指令的默认值
+	directiveSetter bool             // setter 是否来自 //lombok:setter 等类型指令的默认值
+	delegateMethods []delegateMethod // delegate 需要生成的转发方法，按方法名排序
 }
 
 func NewProperty(name string) *Property {
@@ -129,6 +223,11 @@ func NewProperty(name string) *Property {
 	}
 }
 
+// HasAccessor 判断属性是否需要生成 getter / setter / wither / 集合辅助方法 / 迭代器 / 转发方法
+func (prop *Property) HasAccessor() bool {
+	return prop.Getter != "" || prop.Setter != "" || prop.Wither != "" || prop.Coll != "" || prop.Iter != "" || prop.Delegate
+}
+
 func (prop *Property) ExistsGetter(name string) bool {
 	for _, getter := range prop.existingGetters {
 		if getter == name {
@@ -168,3 +267,14 @@ func (prop *Property) RecordExistingSetter(name string) {
 func (prop *Property) ExistingSetters() iter.Seq[string] {
 	return slices.Values(prop.existingSetters)
 }
+
+func (prop *Property) RecordExistingChainSetter(name string) {
+	if prop.chainSetters == nil {
+		prop.chainSetters = make(map[string]bool)
+	}
+	prop.chainSetters[name] = true
+}
+
+func (prop *Property) IsExistingChainSetter(name string) bool {
+	return prop.chainSetters[name]
+}
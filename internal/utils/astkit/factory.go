@@ -16,6 +16,27 @@ func Fields(fields ...*ast.Field) *ast.FieldList {
 }
 
 func RefType(typ ast.Expr) ast.Expr { return &ast.StarExpr{X: typ} }
+
+// GenericType 构建泛型类型实例化表达式，无类型参数时直接返回 typ
+func GenericType(typ ast.Expr, args ...ast.Expr) ast.Expr {
+	switch len(args) {
+	case 0:
+		return typ
+	case 1:
+		return &ast.IndexExpr{X: typ, Index: args[0]}
+	default:
+		return &ast.IndexListExpr{X: typ, Indices: args}
+	}
+}
+
+// SelectorExpr 构建链式选择表达式，如 x.a.b
+func SelectorExpr(x ast.Expr, names ...string) ast.Expr {
+	for _, name := range names {
+		x = &ast.SelectorExpr{X: x, Sel: ast.NewIdent(name)}
+	}
+	return x
+}
+
 func AssignStmt(variable ast.Expr, value ast.Expr) ast.Stmt {
 	return &ast.AssignStmt{
 		Lhs: []ast.Expr{variable},